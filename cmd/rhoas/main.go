@@ -73,6 +73,10 @@ func main() {
 
 	if err = cmdutil.CheckSurveyError(err); err != nil {
 		logger.Error("Error:", err)
+		// the current Kafka instance may have been deleted since it was selected
+		if _, err = cmdutil.HandleStaleKafkaInstance(cmdFactory, err); cmdutil.CheckSurveyError(err) != nil {
+			logger.Error("Error:", err)
+		}
 		os.Exit(1)
 	}
 }
@@ -105,7 +109,10 @@ func initConfig(f *factory.Factory) {
 		fmt.Fprintf(f.IOStreams.ErrOut, "Error migrating config file to new location: %v", err)
 	}
 
-	cfgFile, err := f.Config.Load()
+	// the user configuration is loaded without the project context,
+	// so that no Kafka instance is looked up before a command needs it
+	userCfg := config.NewFile()
+	cfgFile, err := userCfg.Load()
 
 	if cfgFile != nil {
 		return
@@ -116,7 +123,7 @@ func initConfig(f *factory.Factory) {
 	}
 
 	cfgFile = &config.Config{}
-	if err := f.Config.Save(cfgFile); err != nil {
+	if err := userCfg.Save(cfgFile); err != nil {
 		fmt.Fprintln(f.IOStreams.ErrOut, err)
 		os.Exit(1)
 	}
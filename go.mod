@@ -12,8 +12,10 @@ require (
 	github.com/fatih/color v1.12.0
 	github.com/google/go-github v17.0.0+incompatible
 	github.com/google/go-querystring v1.0.0 // indirect
+	github.com/jhump/protoreflect v1.8.2
 	github.com/kataras/tablewriter v0.0.0-20180708051242-e063d29b7c23 // indirect
 	github.com/landoop/tableprinter v0.0.0-20201125135848-89e81fc956e7
+	github.com/linkedin/goavro/v2 v2.10.0
 	github.com/mattn/go-isatty v0.0.13
 	github.com/mattn/go-runewidth v0.0.12 // indirect
 	github.com/nicksnyder/go-i18n/v2 v2.1.2
@@ -23,6 +25,8 @@ require (
 	github.com/pquerna/cachecontrol v0.1.0 // indirect
 	github.com/redhat-developer/app-services-sdk-go v0.3.4
 	github.com/redhat-developer/service-binding-operator v0.8.0
+	github.com/santhosh-tekuri/jsonschema/v3 v3.0.1
+	github.com/segmentio/kafka-go v0.4.17
 	github.com/spf13/cobra v1.1.3
 	github.com/spf13/pflag v1.0.5
 	gitlab.com/c0b/go-ordered-json v0.0.0-20201030195603-febf46534d5a
@@ -0,0 +1,309 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LocalFileName is the name of the project context file
+const LocalFileName = ".rhoas.yaml"
+
+// LocalConfig is a project context file, which sets the service instances and defaults
+// used in its directory and all subdirectories, overriding the values of the user configuration
+type LocalConfig struct {
+	Kafka          *LocalKafkaConfig   `yaml:"kafka,omitempty"`
+	Registry       *LocalServiceConfig `yaml:"serviceregistry,omitempty"`
+	ServiceAccount *LocalServiceConfig `yaml:"serviceaccount,omitempty"`
+}
+
+// LocalKafkaConfig pins a Kafka instance by ID or name, and the default topic
+type LocalKafkaConfig struct {
+	ID    string `yaml:"id,omitempty"`
+	Name  string `yaml:"name,omitempty"`
+	Topic string `yaml:"topic,omitempty"`
+}
+
+// LocalServiceConfig sets the instance of a service used in a directory
+type LocalServiceConfig struct {
+	ID string `yaml:"id"`
+}
+
+// HasKafka returns true if the local configuration pins a Kafka instance
+func (c *LocalConfig) HasKafka() bool {
+	return c != nil && c.Kafka != nil && (c.Kafka.ID != "" || c.Kafka.Name != "")
+}
+
+// HasTopic returns true if the local configuration sets a default topic
+func (c *LocalConfig) HasTopic() bool {
+	return c != nil && c.Kafka != nil && c.Kafka.Topic != ""
+}
+
+// HasRegistry returns true if the local configuration sets a Service Registry instance
+func (c *LocalConfig) HasRegistry() bool {
+	return c != nil && c.Registry != nil && c.Registry.ID != ""
+}
+
+// HasServiceAccount returns true if the local configuration sets a service account
+func (c *LocalConfig) HasServiceAccount() bool {
+	return c != nil && c.ServiceAccount != nil && c.ServiceAccount.ID != ""
+}
+
+// FindLocal walks up from dir and returns the path of the first project context file found.
+// It returns an empty path if neither dir nor its parents contain a project context file, or if dir is empty.
+func FindLocal(dir string) (string, error) {
+	if dir == "" {
+		return "", nil
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(dir, LocalFileName)
+		info, err := os.Stat(path)
+		if err == nil && !info.IsDir() {
+			return path, nil
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return "", fmt.Errorf("%v: %w", "unable to check if local config file exists", err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// LoadLocal loads the project context file which applies to dir.
+// It returns a nil configuration if there is no project context file.
+func LoadLocal(dir string) (cfg *LocalConfig, path string, err error) {
+	path, err = FindLocal(dir)
+	if err != nil || path == "" {
+		return nil, "", err
+	}
+
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("%v: %w", "unable to read local config file", err)
+	}
+
+	cfg = &LocalConfig{}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, "", fmt.Errorf("unable to parse local config file %v: %w", path, err)
+	}
+
+	return cfg, path, nil
+}
+
+// OverrideOptions are the sources of the values used instead of the values of the user configuration
+type OverrideOptions struct {
+	// Dir is the directory from which the project context file is searched
+	Dir string
+	// KafkaInstance returns the name or ID of the Kafka instance selected on the command line,
+	// which takes precedence over the project context
+	KafkaInstance func() string
+	// ResolveKafka returns the ID of the Kafka instance with the given name or ID
+	ResolveKafka func(nameOrID string) (string, error)
+	// KafkaNotResolved is called once when the Kafka instance pinned by name in the project context file at path cannot be resolved
+	KafkaNotResolved func(name string, path string, err error)
+}
+
+// NewLocalOverride wraps cfg so that the Kafka instance selected on the command line and the values
+// of the project context file which applies to opts.Dir are used instead of the values of the user configuration.
+// The overridden values are never written to the user configuration.
+func NewLocalOverride(cfg IConfig, opts OverrideOptions) IConfig {
+	return &localOverride{
+		IConfig:        cfg,
+		opts:           opts,
+		kafkaIDs:       map[string]string{},
+		kafkaErrs:      map[string]error{},
+		kafka:          map[*KafkaConfig]kafkaOverride{},
+		registry:       map[*RegistryConfig]registryOverride{},
+		serviceAccount: map[*ServiceAccountConfig]serviceAccountOverride{},
+	}
+}
+
+// kafkaOverride is the user's own Kafka config, and the value which replaced it when it was loaded
+type kafkaOverride struct {
+	global *KafkaConfig
+	loaded KafkaConfig
+}
+
+type registryOverride struct {
+	global *RegistryConfig
+	loaded RegistryConfig
+}
+
+type serviceAccountOverride struct {
+	global *ServiceAccountConfig
+	loaded ServiceAccountConfig
+}
+
+// localOverride remembers which service configs were replaced and their loaded values,
+// so that Save can restore the user's own values where they were left unchanged
+type localOverride struct {
+	IConfig
+	opts OverrideOptions
+
+	mu             sync.Mutex
+	kafkaIDs       map[string]string
+	kafkaErrs      map[string]error
+	kafka          map[*KafkaConfig]kafkaOverride
+	registry       map[*RegistryConfig]registryOverride
+	serviceAccount map[*ServiceAccountConfig]serviceAccountOverride
+}
+
+// Load loads the user configuration and applies the selected Kafka instance and the project context on top of it
+func (c *localOverride) Load() (*Config, error) {
+	cfg, err := c.IConfig.Load()
+	if err != nil {
+		return cfg, err
+	}
+
+	local, localPath, err := LoadLocal(c.opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var instance string
+	if c.opts.KafkaInstance != nil {
+		instance = c.opts.KafkaInstance()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if instance != "" || local.HasKafka() || local.HasTopic() {
+		override := &KafkaConfig{}
+		if global := cfg.Services.Kafka; global != nil {
+			*override = *global
+		}
+		switch {
+		case instance != "":
+			// the instance was explicitly selected, so failing to find it is an error
+			if override.ClusterID, err = c.kafkaID(instance); err != nil {
+				return nil, err
+			}
+		case local.Kafka.ID != "":
+			override.ClusterID = local.Kafka.ID
+		case local.Kafka.Name != "":
+			// the name is only looked up by commands which use the Kafka instance,
+			// and the user's instance is never used instead of an instance which cannot be found
+			override.ClusterID = ""
+			name := local.Kafka.Name
+			cfg.resolveKafka = func() { c.resolveLocalKafka(override, name, localPath) }
+		}
+		if local.HasTopic() {
+			override.Topic = local.Kafka.Topic
+		}
+		c.kafka[override] = kafkaOverride{global: cfg.Services.Kafka, loaded: *override}
+		cfg.Services.Kafka = override
+	}
+	if local.HasRegistry() {
+		override := &RegistryConfig{InstanceID: local.Registry.ID}
+		c.registry[override] = registryOverride{global: cfg.Services.Registry, loaded: *override}
+		cfg.Services.Registry = override
+	}
+	if local.HasServiceAccount() {
+		override := &ServiceAccountConfig{ID: local.ServiceAccount.ID}
+		c.serviceAccount[override] = serviceAccountOverride{global: cfg.Services.ServiceAccount, loaded: *override}
+		cfg.Services.ServiceAccount = override
+	}
+
+	return cfg, nil
+}
+
+// kafkaID resolves the ID of the Kafka instance with the given name or ID once per process
+func (c *localOverride) kafkaID(nameOrID string) (string, error) {
+	if id, ok := c.kafkaIDs[nameOrID]; ok {
+		return id, c.kafkaErrs[nameOrID]
+	}
+
+	if c.opts.ResolveKafka == nil {
+		return nameOrID, nil
+	}
+
+	// the name is resolved while the lock is held, so that it is only requested once
+	id, err := c.opts.ResolveKafka(nameOrID)
+	c.kafkaIDs[nameOrID] = id
+	c.kafkaErrs[nameOrID] = err
+
+	return id, err
+}
+
+// resolveLocalKafka sets the ID of the Kafka instance pinned by name in the project context file at path.
+// An instance which cannot be resolved is left unset.
+func (c *localOverride) resolveLocalKafka(override *KafkaConfig, name string, path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, resolved := c.kafkaIDs[name]
+	id, err := c.kafkaID(name)
+	if err != nil {
+		if !resolved && c.opts.KafkaNotResolved != nil {
+			c.opts.KafkaNotResolved(name, path, err)
+		}
+		return
+	}
+
+	override.ClusterID = id
+	// the resolved ID was not changed by the caller, so it is not saved to the user configuration
+	if o, ok := c.kafka[override]; ok {
+		o.loaded.ClusterID = id
+		c.kafka[override] = o
+	}
+}
+
+// Save saves cfg, keeping the user's own values where they were only overridden.
+// Fields of an overridden config which were changed since it was loaded are saved to the user configuration.
+func (c *localOverride) Save(cfg *Config) error {
+	c.mu.Lock()
+	saved := *cfg
+	if o, ok := c.kafka[cfg.Services.Kafka]; ok {
+		saved.Services.Kafka = o.merge(*cfg.Services.Kafka)
+	}
+	if o, ok := c.registry[cfg.Services.Registry]; ok {
+		saved.Services.Registry = o.global
+		if *cfg.Services.Registry != o.loaded {
+			saved.Services.Registry = &RegistryConfig{InstanceID: cfg.Services.Registry.InstanceID}
+		}
+	}
+	if o, ok := c.serviceAccount[cfg.Services.ServiceAccount]; ok {
+		saved.Services.ServiceAccount = o.global
+		if *cfg.Services.ServiceAccount != o.loaded {
+			saved.Services.ServiceAccount = &ServiceAccountConfig{ID: cfg.Services.ServiceAccount.ID}
+		}
+	}
+	c.mu.Unlock()
+
+	return c.IConfig.Save(&saved)
+}
+
+// merge applies the fields of current which differ from the loaded value to the user's own Kafka config
+func (o kafkaOverride) merge(current KafkaConfig) *KafkaConfig {
+	if current == o.loaded {
+		return o.global
+	}
+
+	merged := &KafkaConfig{}
+	if o.global != nil {
+		*merged = *o.global
+	}
+	if current.ClusterID != o.loaded.ClusterID {
+		merged.ClusterID = current.ClusterID
+	}
+	if current.Topic != o.loaded.Topic {
+		merged.Topic = current.Topic
+	}
+	return merged
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalOverride(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the user configuration is stored as JSON, so every Load returns a new value
+	stored := Config{
+		AccessToken: "token",
+		Services: ServiceConfigMap{
+			Kafka: &KafkaConfig{ClusterID: "global-kafka"},
+		},
+	}
+	base := &IConfigMock{
+		LoadFunc: func() (*Config, error) {
+			cfg := stored
+			return &cfg, nil
+		},
+		SaveFunc: func(cfg *Config) error {
+			stored = *cfg
+			return nil
+		},
+	}
+
+	resolved := 0
+	resolveKafka := func(name string) (string, error) {
+		resolved++
+		return name + "-id", nil
+	}
+	cfgFile := NewLocalOverride(base, OverrideOptions{Dir: dir, ResolveKafka: resolveKafka})
+
+	// without a local configuration file the user configuration is used
+	cfg, err := cfgFile.Load()
+	if err != nil || cfg.Services.Kafka.ClusterID != "global-kafka" {
+		t.Fatalf("Load() = %+v, %v", cfg.Services.Kafka, err)
+	}
+
+	local := "kafka:\n  id: local-kafka\n  topic: orders\nserviceregistry:\n  id: \"42\"\nserviceaccount:\n  id: srvc-acct-1\n"
+	if err = ioutil.WriteFile(filepath.Join(dir, LocalFileName), []byte(local), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err = cfgFile.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Services.Kafka.ClusterID != "local-kafka" || cfg.DefaultTopic() != "orders" ||
+		cfg.Services.Registry.InstanceID != "42" || cfg.Services.ServiceAccount.ID != "srvc-acct-1" {
+		t.Errorf("Load() = %+v, %+v, %+v, want the local values", cfg.Services.Kafka, cfg.Services.Registry, cfg.Services.ServiceAccount)
+	}
+
+	// saving other changes must not persist the local instances
+	cfg.AccessToken = "refreshed"
+	if err = cfgFile.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if stored.AccessToken != "refreshed" || stored.Services.Kafka.ClusterID != "global-kafka" || stored.Services.Kafka.Topic != "" ||
+		stored.Services.Registry != nil || stored.Services.ServiceAccount != nil {
+		t.Errorf("saved %+v, %+v, %+v, %+v", stored.AccessToken, stored.Services.Kafka, stored.Services.Registry, stored.Services.ServiceAccount)
+	}
+	if cfg.Services.Kafka.ClusterID != "local-kafka" {
+		t.Errorf("Save() modified the given config")
+	}
+
+	// an instance set explicitly replaces the user's current instance
+	cfg, _ = cfgFile.Load()
+	cfg.Services.Kafka = &KafkaConfig{ClusterID: "new-kafka"}
+	if err = cfgFile.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if stored.Services.Kafka.ClusterID != "new-kafka" {
+		t.Errorf("saved Kafka instance = %+v", stored.Services.Kafka)
+	}
+
+	// fields changed in place are saved, while the other overridden values are not
+	cfg, _ = cfgFile.Load()
+	cfg.Services.Kafka.ClusterID = "changed-kafka"
+	cfg.Services.Registry.InstanceID = "43"
+	if err = cfgFile.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if stored.Services.Kafka.ClusterID != "changed-kafka" || stored.Services.Kafka.Topic != "" ||
+		stored.Services.Registry.InstanceID != "43" || stored.Services.ServiceAccount != nil {
+		t.Errorf("saved %+v, %+v, %+v", stored.Services.Kafka, stored.Services.Registry, stored.Services.ServiceAccount)
+	}
+
+	// the project context applies to subdirectories, and instances can be pinned by name
+	subdir := filepath.Join(dir, "src", "main")
+	if err = os.MkdirAll(subdir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, LocalFileName), []byte("kafka:\n  name: my-kafka\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfgFile = NewLocalOverride(base, OverrideOptions{Dir: subdir, ResolveKafka: resolveKafka})
+	for i := 0; i < 2; i++ {
+		cfg, err = cfgFile.Load()
+		if err != nil || !cfg.HasKafka() || cfg.Services.Kafka.ClusterID != "my-kafka-id" {
+			t.Fatalf("Load() = %+v, %v", cfg.Services.Kafka, err)
+		}
+	}
+
+	// the resolved ID is not saved to the user configuration
+	global := *stored.Services.Kafka
+	if err = cfgFile.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if *stored.Services.Kafka != global {
+		t.Errorf("saved Kafka instance = %+v", stored.Services.Kafka)
+	}
+	if resolved != 1 {
+		t.Errorf("the Kafka instance name was resolved %v times", resolved)
+	}
+}
+
+func TestLocalOverrideKafkaInstance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = ioutil.WriteFile(filepath.Join(dir, LocalFileName), []byte("kafka:\n  name: unknown\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := Config{AccessToken: "token", Services: ServiceConfigMap{Kafka: &KafkaConfig{ClusterID: "global-kafka"}}}
+	base := &IConfigMock{
+		LoadFunc: func() (*Config, error) {
+			cfg := stored
+			return &cfg, nil
+		},
+		SaveFunc: func(cfg *Config) error {
+			stored = *cfg
+			return nil
+		},
+	}
+	var instance string
+	resolved := 0
+	notResolved := []string{}
+	cfgFile := NewLocalOverride(base, OverrideOptions{
+		Dir:           dir,
+		KafkaInstance: func() string { return instance },
+		ResolveKafka: func(nameOrID string) (string, error) {
+			resolved++
+			if nameOrID == "other-kafka" {
+				return "other-kafka-id", nil
+			}
+			return "", errors.New("not found")
+		},
+		KafkaNotResolved: func(name string, path string, err error) {
+			notResolved = append(notResolved, name)
+		},
+	})
+
+	// commands which do not use the Kafka instance, such as login or kafka create,
+	// load and save the configuration without looking up the pinned name
+	cfg, err := cfgFile.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.AccessToken = "new-token"
+	if err = cfgFile.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if resolved != 0 || stored.AccessToken != "new-token" || stored.Services.Kafka.ClusterID != "global-kafka" {
+		t.Errorf("saved %+v after resolving %v times", stored, resolved)
+	}
+
+	// a pinned name which cannot be resolved leaves the instance unset, and is only looked up and reported once
+	for i := 0; i < 2; i++ {
+		cfg, err = cfgFile.Load()
+		if err != nil || cfg.HasKafka() {
+			t.Errorf("Load() = %+v, %v", cfg.Services.Kafka, err)
+		}
+	}
+	if resolved != 1 || len(notResolved) != 1 || notResolved[0] != "unknown" {
+		t.Errorf("resolved %v times, reported %v", resolved, notResolved)
+	}
+
+	// the instance selected on the command line takes precedence over the project context
+	instance = "other-kafka"
+	cfg, err = cfgFile.Load()
+	if err != nil || cfg.Services.Kafka.ClusterID != "other-kafka-id" {
+		t.Errorf("Load() = %+v, %v", cfg.Services.Kafka, err)
+	}
+
+	// an instance selected on the command line which cannot be found is an error
+	instance = "unknown"
+	if _, err = cfgFile.Load(); err == nil {
+		t.Error("Load() did not fail for an unknown instance")
+	}
+}
+
+func TestLoadLocalInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = ioutil.WriteFile(filepath.Join(dir, LocalFileName), []byte("kafka: [\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = LoadLocal(dir); err == nil {
+		t.Error("LoadLocal() did not fail for an invalid file")
+	}
+}
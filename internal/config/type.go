@@ -2,6 +2,7 @@ package config
 
 // IConfig is an interface which describes the functions
 // needed to read/write from a config
+//
 //go:generate moq -out ./config_mock.go . IConfig
 type IConfig interface {
 	Load() (*Config, error)
@@ -23,19 +24,58 @@ type Config struct {
 	ClientID        string           `json:"client_id,omitempty" doc:"OpenID client identifier."`
 	Insecure        bool             `json:"insecure,omitempty" doc:"Enables insecure communication with the server. This disables verification of TLS certificates and host names."`
 	Scopes          []string         `json:"scopes,omitempty" doc:"OpenID scope. If this option is used it will replace completely the default scopes. Can be repeated multiple times to specify multiple scopes."`
+
+	// resolveKafka looks up the Kafka instance pinned by name in the project context the first time it is used
+	resolveKafka func()
 }
 
 // ServiceConfigMap is a map of configs for the application services
 type ServiceConfigMap struct {
-	Kafka *KafkaConfig `json:"kafka"`
+	Kafka          *KafkaConfig          `json:"kafka"`
+	Registry       *RegistryConfig       `json:"serviceregistry,omitempty"`
+	ServiceAccount *ServiceAccountConfig `json:"serviceaccount,omitempty"`
 }
 
 // KafkaConfig is the config for the Kafka service
 type KafkaConfig struct {
 	ClusterID string `json:"clusterId"`
+	// Topic is the topic used by topic commands when no topic name is given
+	Topic string `json:"topic,omitempty"`
+}
+
+// RegistryConfig is the config for the Service Registry service
+type RegistryConfig struct {
+	InstanceID string `json:"instanceId"`
+}
+
+// ServiceAccountConfig is the config for the service account used by default
+type ServiceAccountConfig struct {
+	ID string `json:"id"`
 }
 
 func (c *Config) HasKafka() bool {
+	if resolve := c.resolveKafka; resolve != nil {
+		c.resolveKafka = nil
+		resolve()
+	}
 	return c.Services.Kafka != nil &&
 		c.Services.Kafka.ClusterID != ""
 }
+
+func (c *Config) HasRegistry() bool {
+	return c.Services.Registry != nil &&
+		c.Services.Registry.InstanceID != ""
+}
+
+func (c *Config) HasServiceAccount() bool {
+	return c.Services.ServiceAccount != nil &&
+		c.Services.ServiceAccount.ID != ""
+}
+
+// DefaultTopic returns the topic used when no topic name is given
+func (c *Config) DefaultTopic() string {
+	if c.Services.Kafka == nil {
+		return ""
+	}
+	return c.Services.Kafka.Topic
+}
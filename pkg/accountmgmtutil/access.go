@@ -0,0 +1,107 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// ResourceTypeCluster is the type of resource of clusters, including Kafka instances
+const ResourceTypeCluster = "Cluster"
+
+// ResourceTypeSubscription is the type of resource of subscriptions
+const ResourceTypeSubscription = "Subscription"
+
+// AccessReviewActions are the actions which can be reviewed
+var AccessReviewActions = []string{"get", "list", "create", "delete", "update"}
+
+// AccessReviewResourceTypes are the types of resource which can be reviewed
+var AccessReviewResourceTypes = []string{
+	"AccessToken",
+	"Account",
+	ResourceTypeCluster,
+	"ClusterMetric",
+	"Dashboard",
+	"Organization",
+	"Permission",
+	"QuotaSummary",
+	"ResourceQuota",
+	"Role",
+	"RoleBinding",
+	ResourceTypeSubscription,
+}
+
+// ResourceReviewActions are the actions for which the allowed resources can be listed
+var ResourceReviewActions = []string{"get", "delete", "update"}
+
+// ResourceReviewResourceTypes are the types of resource which can be listed
+var ResourceReviewResourceTypes = []string{ResourceTypeCluster, ResourceTypeSubscription}
+
+// AccessReview is an action on a type of resource, optionally limited to a single organization, subscription or cluster
+type AccessReview struct {
+	Action         string
+	ResourceType   string
+	OrganizationID string
+	SubscriptionID string
+	ClusterID      string
+}
+
+// FindResourceType returns the type of resource with the given name, ignoring its case
+func FindResourceType(types []string, name string) (string, bool) {
+	for _, t := range types {
+		if strings.EqualFold(t, name) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// ReviewAccess returns true if the current account is allowed to perform the action of review
+func ReviewAccess(ctx context.Context, api amsclient.DefaultApi, review AccessReview) (bool, error) {
+	request := amsclient.SelfAccessReview{
+		Action:       review.Action,
+		ResourceType: review.ResourceType,
+	}
+	if review.OrganizationID != "" {
+		request.OrganizationId = &review.OrganizationID
+	}
+	if review.SubscriptionID != "" {
+		request.SubscriptionId = &review.SubscriptionID
+	}
+	if review.ClusterID != "" {
+		request.ClusterId = &review.ClusterID
+	}
+
+	response, _, err := api.ApiAuthorizationsV1SelfAccessReviewPost(ctx).
+		SelfAccessReview(request).
+		Execute()
+	if err != nil {
+		return false, err
+	}
+
+	return response.GetAllowed(), nil
+}
+
+// ListAllowedResources returns the IDs of the resources on which the current account is allowed to perform action
+func ListAllowedResources(ctx context.Context, api amsclient.DefaultApi, action string, resourceType string) ([]string, error) {
+	review, _, err := api.ApiAuthorizationsV1SelfResourceReviewPost(ctx).
+		SelfResourceReviewRequest(amsclient.SelfResourceReviewRequest{
+			Action:       &action,
+			ResourceType: &resourceType,
+		}).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := review.GetSubscriptionIds()
+	if resourceType == ResourceTypeCluster {
+		ids = review.GetClusterIds()
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+
+	return ids, nil
+}
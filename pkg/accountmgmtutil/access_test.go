@@ -0,0 +1,40 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+func TestFindResourceType(t *testing.T) {
+	if got, ok := FindResourceType(AccessReviewResourceTypes, "rolebinding"); !ok || got != "RoleBinding" {
+		t.Errorf("FindResourceType() = %v, %v, want RoleBinding", got, ok)
+	}
+	if _, ok := FindResourceType(ResourceReviewResourceTypes, "RoleBinding"); ok {
+		t.Error("FindResourceType() found a type which is not in the list")
+	}
+}
+
+func TestListAllowedResources(t *testing.T) {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAuthorizationsV1SelfResourceReviewPostFunc = func(ctx context.Context) amsclient.ApiApiAuthorizationsV1SelfResourceReviewPostRequest {
+		return amsclient.ApiApiAuthorizationsV1SelfResourceReviewPostRequest{ApiService: mock}
+	}
+	mock.ApiAuthorizationsV1SelfResourceReviewPostExecuteFunc = func(amsclient.ApiApiAuthorizationsV1SelfResourceReviewPostRequest) (amsclient.SelfResourceReview, *http.Response, error) {
+		return amsclient.SelfResourceReview{
+			ClusterIds:      []string{"cluster-1"},
+			SubscriptionIds: []string{"sub-1", "sub-2"},
+		}, nil, nil
+	}
+
+	ctx := context.Background()
+	if ids, err := ListAllowedResources(ctx, mock, "update", ResourceTypeCluster); err != nil || !reflect.DeepEqual(ids, []string{"cluster-1"}) {
+		t.Errorf("ListAllowedResources(Cluster) = %v, %v", ids, err)
+	}
+	if ids, err := ListAllowedResources(ctx, mock, "update", ResourceTypeSubscription); err != nil || !reflect.DeepEqual(ids, []string{"sub-1", "sub-2"}) {
+		t.Errorf("ListAllowedResources(Subscription) = %v, %v", ids, err)
+	}
+}
@@ -0,0 +1,185 @@
+// Package accountmgmtutil contains functions for reading the account and quota of the user
+// from the account management service
+package accountmgmtutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// KafkaResourceName is the name of the resource consumed by Kafka instances
+const KafkaResourceName = "rhosak"
+
+const (
+	// ProductStandard is the product of the quota of standard Kafka instances
+	ProductStandard = "RHOSAK"
+	// ProductTrial is the product of the quota of trial Kafka instances
+	ProductTrial = "RHOSAKTrial"
+)
+
+// availability zone types of the resources consumed by a quota
+const (
+	AvailabilityZoneSingle = "single"
+	AvailabilityZoneMulti  = "multi"
+	AvailabilityZoneAny    = "any"
+)
+
+// Quota is the amount of a product the organization is allowed to consume, and how much of it is used
+type Quota struct {
+	ID       string `json:"id" yaml:"id"`
+	Product  string `json:"product" yaml:"product"`
+	Allowed  int    `json:"allowed" yaml:"allowed"`
+	Consumed int    `json:"consumed" yaml:"consumed"`
+	// Cost is the amount of quota consumed by each instance
+	Cost int `json:"cost" yaml:"cost"`
+	// AvailabilityZoneType is the type of availability zone of the instances which consume the quota
+	AvailabilityZoneType string `json:"availability_zone_type,omitempty" yaml:"availability_zone_type,omitempty"`
+}
+
+// SKU is a stock keeping unit of Kafka instances the organization is entitled to
+type SKU struct {
+	SKU                  string `json:"sku" yaml:"sku"`
+	Count                int    `json:"count" yaml:"count"`
+	Allowed              int    `json:"allowed" yaml:"allowed"`
+	Type                 string `json:"type,omitempty" yaml:"type,omitempty"`
+	AvailabilityZoneType string `json:"availability_zone_type,omitempty" yaml:"availability_zone_type,omitempty"`
+}
+
+// Remaining returns the amount of quota which is not used
+func (q Quota) Remaining() int {
+	if q.Consumed > q.Allowed {
+		return 0
+	}
+	return q.Allowed - q.Consumed
+}
+
+// Instances returns the number of instances which consume the given amount of quota.
+// A quota without a cost per instance is already counted in instances.
+func (q Quota) Instances(amount int) int {
+	if q.Cost <= 1 {
+		return amount
+	}
+	return amount / q.Cost
+}
+
+// GetOrganizationID returns the ID of the organization of the current account
+func GetOrganizationID(ctx context.Context, api amsclient.DefaultApi) (string, error) {
+	account, _, err := api.ApiAccountsMgmtV1CurrentAccountGet(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	org := account.GetOrganization()
+
+	return org.GetId(), nil
+}
+
+// GetAccountRoles returns the IDs of the roles bound to an account
+func GetAccountRoles(ctx context.Context, api amsclient.DefaultApi, accountID string) ([]string, error) {
+	roleBindings, _, err := api.ApiAccountsMgmtV1RoleBindingsGet(ctx).
+		Search(fmt.Sprintf("account.id='%v'", accountID)).
+		Size(100).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []string{}
+	seen := map[string]bool{}
+	for _, b := range roleBindings.GetItems() {
+		role := b.GetRole()
+		if id := role.GetId(); id != "" && !seen[id] {
+			seen[id] = true
+			roles = append(roles, id)
+		}
+	}
+
+	return roles, nil
+}
+
+// GetKafkaQuotas returns the quotas of Kafka instances of an organization
+func GetKafkaQuotas(ctx context.Context, api amsclient.DefaultApi, orgID string) ([]Quota, error) {
+	quotaCosts, _, err := api.ApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGet(ctx, orgID).
+		FetchRelatedResources(true).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := []Quota{}
+	for _, q := range quotaCosts.GetItems() {
+		for _, r := range q.GetRelatedResources() {
+			if r.GetResourceName() != KafkaResourceName {
+				continue
+			}
+			quotas = append(quotas, Quota{
+				ID:                   q.GetQuotaId(),
+				Product:              r.GetProduct(),
+				Allowed:              int(q.GetAllowed()),
+				Consumed:             int(q.GetConsumed()),
+				Cost:                 int(r.GetCost()),
+				AvailabilityZoneType: r.GetAvailabilityZoneType(),
+			})
+			break
+		}
+	}
+
+	return quotas, nil
+}
+
+// GetKafkaSKUs returns the SKUs of Kafka instances of an organization
+func GetKafkaSKUs(ctx context.Context, api amsclient.DefaultApi, orgID string) ([]SKU, error) {
+	resourceQuotas, _, err := api.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGet(ctx, orgID).
+		Size(100).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	skus := []SKU{}
+	for _, q := range resourceQuotas.GetItems() {
+		if q.GetResourceName() != KafkaResourceName {
+			continue
+		}
+		skus = append(skus, SKU{
+			SKU:                  q.GetSku(),
+			Count:                int(q.GetSkuCount()),
+			Allowed:              int(q.GetAllowed()),
+			Type:                 q.GetType(),
+			AvailabilityZoneType: q.GetAvailabilityZoneType(),
+		})
+	}
+
+	return skus, nil
+}
+
+// FilterByProduct returns the quotas of a product
+func FilterByProduct(quotas []Quota, product string) []Quota {
+	filtered := []Quota{}
+	for _, q := range quotas {
+		if q.Product == product {
+			filtered = append(filtered, q)
+		}
+	}
+	return filtered
+}
+
+// FilterByAvailabilityZone returns the quotas which can be consumed by instances in multiple availability zones,
+// or in a single one when multiAZ is false. Quotas without an availability zone type can be consumed by both.
+func FilterByAvailabilityZone(quotas []Quota, multiAZ bool) []Quota {
+	zoneType := AvailabilityZoneSingle
+	if multiAZ {
+		zoneType = AvailabilityZoneMulti
+	}
+
+	filtered := []Quota{}
+	for _, q := range quotas {
+		switch q.AvailabilityZoneType {
+		case zoneType, AvailabilityZoneAny, "":
+			filtered = append(filtered, q)
+		}
+	}
+	return filtered
+}
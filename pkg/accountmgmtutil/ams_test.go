@@ -0,0 +1,96 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+func newAPIMock(quotaCosts []amsclient.QuotaCost) *amsclient.DefaultApiMock {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAccountsMgmtV1CurrentAccountGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1CurrentAccountGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest) (amsclient.Account, *http.Response, error) {
+		orgID := "org-1"
+		return amsclient.Account{Organization: &amsclient.Organization{Id: &orgID}}, nil, nil
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetFunc = func(ctx context.Context, orgID string) amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest) (amsclient.QuotaCostList, *http.Response, error) {
+		return amsclient.QuotaCostList{Items: quotaCosts}, nil, nil
+	}
+	return mock
+}
+
+func TestGetKafkaQuotas(t *testing.T) {
+	api := newAPIMock([]amsclient.QuotaCost{
+		{
+			QuotaId:  "cluster|rhinfra|rhosak|marketplace",
+			Allowed:  5,
+			Consumed: 2,
+			RelatedResources: &[]amsclient.RelatedResource{
+				{ResourceName: strPtr("rhosak"), Product: "RHOSAK", Cost: 1, AvailabilityZoneType: "multi"},
+			},
+		},
+		{
+			QuotaId:  "cluster|rhinfra|rhosak|eval",
+			Allowed:  0,
+			Consumed: 1,
+			RelatedResources: &[]amsclient.RelatedResource{
+				{ResourceName: strPtr("rhosak"), Product: "RHOSAKTrial", Cost: 0},
+			},
+		},
+		{
+			QuotaId: "cluster|byoc|osd",
+			Allowed: 10,
+			RelatedResources: &[]amsclient.RelatedResource{
+				{ResourceName: strPtr("compute.node.aws"), Product: "OSD", Cost: 1},
+			},
+		},
+	})
+
+	orgID, err := GetOrganizationID(context.Background(), api)
+	if err != nil || orgID != "org-1" {
+		t.Fatalf("GetOrganizationID() = %v, %v", orgID, err)
+	}
+
+	quotas, err := GetKafkaQuotas(context.Background(), api, orgID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Quota{
+		{ID: "cluster|rhinfra|rhosak|marketplace", Product: ProductStandard, Allowed: 5, Consumed: 2, Cost: 1, AvailabilityZoneType: AvailabilityZoneMulti},
+		{ID: "cluster|rhinfra|rhosak|eval", Product: ProductTrial, Allowed: 0, Consumed: 1, Cost: 0},
+	}
+	if !reflect.DeepEqual(quotas, want) {
+		t.Errorf("GetKafkaQuotas() = %+v, want %+v", quotas, want)
+	}
+
+	if got := quotas[0].Remaining(); got != 3 {
+		t.Errorf("Remaining() = %v, want 3", got)
+	}
+	if got := quotas[1].Remaining(); got != 0 {
+		t.Errorf("Remaining() = %v, want 0 when the quota is exceeded", got)
+	}
+
+	if got := FilterByProduct(quotas, ProductStandard); len(got) != 1 || got[0].Product != ProductStandard {
+		t.Errorf("FilterByProduct() = %+v", got)
+	}
+
+	if got := FilterByAvailabilityZone(quotas, true); len(got) != 2 {
+		t.Errorf("FilterByAvailabilityZone(multi) = %+v, want both quotas", got)
+	}
+	if got := FilterByAvailabilityZone(quotas, false); len(got) != 1 || got[0].Product != ProductTrial {
+		t.Errorf("FilterByAvailabilityZone(single) = %+v, want the quota without an availability zone type", got)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,19 @@
+package accountmgmtutil
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// IsFeatureToggleEnabled returns true if the feature toggle with the given ID is enabled for the organization
+func IsFeatureToggleEnabled(ctx context.Context, api amsclient.DefaultApi, toggleID string, orgID string) (bool, error) {
+	toggle, _, err := api.ApiAccountsMgmtV1FeatureTogglesIdQueryPost(ctx, toggleID).
+		FeatureToggleQueryRequest(*amsclient.NewFeatureToggleQueryRequest(orgID)).
+		Execute()
+	if err != nil {
+		return false, err
+	}
+
+	return toggle.GetEnabled(), nil
+}
@@ -0,0 +1,116 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// ParseLabel splits a label in the "key=value" format into its key and value
+func ParseLabel(label string) (key string, value string, ok bool) {
+	parts := strings.SplitN(label, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// GetLabels returns the labels of the subscription with the given ID by key, leaving out the internal labels
+func GetLabels(ctx context.Context, api amsclient.DefaultApi, subscriptionID string) (map[string]string, error) {
+	labels, _, err := api.ApiAccountsMgmtV1SubscriptionsIdLabelsGet(ctx, subscriptionID).
+		Size(100).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return labelMap(labels.GetItems()), nil
+}
+
+// SetLabel sets a label of the subscription with the given ID, creating it if it does not exist
+func SetLabel(ctx context.Context, api amsclient.DefaultApi, subscriptionID string, key string, value string, exists bool) error {
+	label := amsclient.Label{Key: key, Value: value}
+
+	var err error
+	if exists {
+		_, _, err = api.ApiAccountsMgmtV1SubscriptionsIdLabelsKeyPatch(ctx, subscriptionID, key).Label(label).Execute()
+	} else {
+		_, _, err = api.ApiAccountsMgmtV1SubscriptionsIdLabelsPost(ctx, subscriptionID).Label(label).Execute()
+	}
+
+	return err
+}
+
+// DeleteLabel deletes a label of the subscription with the given ID
+func DeleteLabel(ctx context.Context, api amsclient.DefaultApi, subscriptionID string, key string) error {
+	_, err := api.ApiAccountsMgmtV1SubscriptionsIdLabelsKeyDelete(ctx, subscriptionID, key).Execute()
+	return err
+}
+
+// FilterKafkaIDsByLabels returns the IDs of the Kafka instances in kafkaIDs whose subscription has all the given labels
+func FilterKafkaIDsByLabels(ctx context.Context, api amsclient.DefaultApi, kafkaIDs []string, labels map[string]string) ([]string, error) {
+	if len(kafkaIDs) == 0 {
+		return []string{}, nil
+	}
+
+	quoted := []string{}
+	for _, id := range kafkaIDs {
+		quoted = append(quoted, quote(id))
+	}
+
+	// the subscriptions are requested a page at a time
+	matching := map[string]bool{}
+	for start := 0; start < len(quoted); start += pageSize {
+		end := start + pageSize
+		if end > len(quoted) {
+			end = len(quoted)
+		}
+
+		subscriptions, _, err := api.ApiAccountsMgmtV1SubscriptionsGet(ctx).
+			Search(fmt.Sprintf("cluster_id in (%v)", strings.Join(quoted[start:end], ", "))).
+			FetchLabels(true).
+			Size(int32(end - start)).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range subscriptions.GetItems() {
+			if hasLabels(labelMap(s.GetLabels()), labels) {
+				matching[s.GetClusterId()] = true
+			}
+		}
+	}
+
+	// the IDs are returned in the order they were given
+	ids := []string{}
+	for _, id := range kafkaIDs {
+		if matching[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func labelMap(labels []amsclient.Label) map[string]string {
+	m := map[string]string{}
+	for _, l := range labels {
+		if l.Internal {
+			continue
+		}
+		m[l.Key] = l.Value
+	}
+	return m
+}
+
+func hasLabels(labels map[string]string, want map[string]string) bool {
+	for k, v := range want {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+func TestParseLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		key   string
+		value string
+		ok    bool
+	}{
+		{label: "team=payments", key: "team", value: "payments", ok: true},
+		{label: "query=a=b", key: "query", value: "a=b", ok: true},
+		{label: "empty=", key: "empty", value: "", ok: true},
+		{label: "team"},
+		{label: "=payments"},
+	}
+	for _, tt := range tests {
+		key, value, ok := ParseLabel(tt.label)
+		if key != tt.key || value != tt.value || ok != tt.ok {
+			t.Errorf("ParseLabel(%q) = %q, %q, %v", tt.label, key, value, ok)
+		}
+	}
+}
+
+func TestFilterKafkaIDsByLabels(t *testing.T) {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAccountsMgmtV1SubscriptionsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1SubscriptionsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest) (amsclient.SubscriptionList, *http.Response, error) {
+		newSubscription := func(kafkaID string, labels ...amsclient.Label) amsclient.Subscription {
+			return amsclient.Subscription{ClusterId: &kafkaID, Labels: &labels}
+		}
+		return amsclient.SubscriptionList{Items: []amsclient.Subscription{
+			newSubscription("kafka-3", amsclient.Label{Key: "team", Value: "payments"}, amsclient.Label{Key: "env", Value: "prod"}),
+			newSubscription("kafka-1", amsclient.Label{Key: "team", Value: "payments"}),
+			newSubscription("kafka-2", amsclient.Label{Key: "team", Value: "billing"}),
+			// internal labels are never matched
+			newSubscription("kafka-4", amsclient.Label{Key: "team", Value: "payments", Internal: true}),
+		}}, nil, nil
+	}
+
+	ids, err := FilterKafkaIDsByLabels(context.Background(), mock, []string{"kafka-1", "kafka-2", "kafka-3", "kafka-4"}, map[string]string{"team": "payments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"kafka-1", "kafka-3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("FilterKafkaIDsByLabels() = %v, want %v", ids, want)
+	}
+
+	ids, err = FilterKafkaIDsByLabels(context.Background(), mock, []string{"kafka-1", "kafka-3"}, map[string]string{"team": "payments", "env": "prod"})
+	if err != nil || !reflect.DeepEqual(ids, []string{"kafka-3"}) {
+		t.Errorf("FilterKafkaIDsByLabels() = %v, %v, want [kafka-3]", ids, err)
+	}
+}
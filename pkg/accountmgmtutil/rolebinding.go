@@ -0,0 +1,189 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// RoleBindingTypeOrganization is the type of role bindings which apply to the whole organization
+const RoleBindingTypeOrganization = "Organization"
+
+// RoleBinding is a role bound to an account of the organization
+type RoleBinding struct {
+	ID        string     `json:"id" yaml:"id"`
+	Username  string     `json:"username" yaml:"username"`
+	AccountID string     `json:"account_id" yaml:"account_id"`
+	RoleID    string     `json:"role_id" yaml:"role_id"`
+	Type      string     `json:"type" yaml:"type"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// pageSize is the number of items requested per page from the account management service
+const pageSize = 100
+
+// RoleBindingFilter selects the role bindings of an account or of a role
+type RoleBindingFilter struct {
+	Username string
+	RoleID   string
+}
+
+// GetAccountByUsername returns the account of the organization with the given username
+func GetAccountByUsername(ctx context.Context, api amsclient.DefaultApi, orgID string, username string) (*amsclient.Account, error) {
+	accounts, _, err := api.ApiAccountsMgmtV1AccountsGet(ctx).
+		Search(fmt.Sprintf("organization.id=%v and username=%v", quote(orgID), quote(username))).
+		Size(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	items := accounts.GetItems()
+	if len(items) == 0 {
+		return nil, fmt.Errorf(`account with username "%v" not found in the organization`, username)
+	}
+
+	return &items[0], nil
+}
+
+// ListRoleBindings returns the role bindings of the organization which match filter
+func ListRoleBindings(ctx context.Context, api amsclient.DefaultApi, orgID string, filter RoleBindingFilter) ([]RoleBinding, error) {
+	query := []string{fmt.Sprintf("organization.id=%v", quote(orgID))}
+	if filter.RoleID != "" {
+		query = append(query, fmt.Sprintf("role.id=%v", quote(filter.RoleID)))
+	}
+	if filter.Username != "" {
+		account, err := GetAccountByUsername(ctx, api, orgID, filter.Username)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, fmt.Sprintf("account.id=%v", quote(account.GetId())))
+	}
+
+	bindings := []RoleBinding{}
+	for page := int32(1); ; page++ {
+		roleBindings, _, err := api.ApiAccountsMgmtV1RoleBindingsGet(ctx).
+			Search(strings.Join(query, " and ")).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range roleBindings.GetItems() {
+			bindings = append(bindings, toRoleBinding(b))
+		}
+
+		if len(roleBindings.GetItems()) == 0 || len(bindings) >= int(roleBindings.GetTotal()) {
+			break
+		}
+	}
+
+	if err := setUsernames(ctx, api, bindings); err != nil {
+		return nil, err
+	}
+
+	return bindings, nil
+}
+
+// GetRoleBinding returns the role binding with the given ID
+func GetRoleBinding(ctx context.Context, api amsclient.DefaultApi, id string) (*RoleBinding, *http.Response, error) {
+	b, httpRes, err := api.ApiAccountsMgmtV1RoleBindingsIdGet(ctx, id).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+
+	bindings := []RoleBinding{toRoleBinding(b)}
+	if err := setUsernames(ctx, api, bindings); err != nil {
+		return nil, httpRes, err
+	}
+
+	return &bindings[0], httpRes, nil
+}
+
+func toRoleBinding(b amsclient.RoleBinding) RoleBinding {
+	account, role := b.GetAccount(), b.GetRole()
+	return RoleBinding{
+		ID:        b.GetId(),
+		AccountID: account.GetId(),
+		RoleID:    role.GetId(),
+		Type:      b.GetType(),
+		CreatedAt: b.CreatedAt,
+	}
+}
+
+// setUsernames sets the usernames of the accounts of the role bindings
+func setUsernames(ctx context.Context, api amsclient.DefaultApi, bindings []RoleBinding) error {
+	usernames, err := getUsernames(ctx, api, bindings)
+	if err != nil {
+		return err
+	}
+	for i := range bindings {
+		bindings[i].Username = usernames[bindings[i].AccountID]
+	}
+	return nil
+}
+
+// getUsernames returns the usernames of the accounts of the role bindings by account ID
+func getUsernames(ctx context.Context, api amsclient.DefaultApi, bindings []RoleBinding) (map[string]string, error) {
+	usernames := map[string]string{}
+
+	ids := []string{}
+	for _, b := range bindings {
+		if _, ok := usernames[b.AccountID]; ok || b.AccountID == "" {
+			continue
+		}
+		usernames[b.AccountID] = ""
+		ids = append(ids, quote(b.AccountID))
+	}
+
+	// the accounts are requested a page at a time
+	for start := 0; start < len(ids); start += pageSize {
+		end := start + pageSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		accounts, _, err := api.ApiAccountsMgmtV1AccountsGet(ctx).
+			Search(fmt.Sprintf("id in (%v)", strings.Join(ids[start:end], ", "))).
+			Size(int32(end - start)).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range accounts.GetItems() {
+			usernames[a.GetId()] = a.GetUsername()
+		}
+	}
+
+	return usernames, nil
+}
+
+// quote returns value as a quoted string of a search query, escaping its single quotes
+// so that it cannot change the query
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// CreateRoleBinding binds a role to an account for the whole organization
+func CreateRoleBinding(ctx context.Context, api amsclient.DefaultApi, orgID string, accountID string, roleID string) (*amsclient.RoleBinding, error) {
+	binding, _, err := api.ApiAccountsMgmtV1RoleBindingsPost(ctx).
+		RoleBindingCreateRequest(amsclient.RoleBindingCreateRequest{
+			AccountId:      accountID,
+			OrganizationId: &orgID,
+			RoleId:         roleID,
+			Type:           RoleBindingTypeOrganization,
+		}).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return &binding, nil
+}
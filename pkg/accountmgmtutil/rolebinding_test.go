@@ -0,0 +1,100 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+func TestListRoleBindings(t *testing.T) {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAccountsMgmtV1RoleBindingsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1RoleBindingsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest) (amsclient.RoleBindingList, *http.Response, error) {
+		return amsclient.RoleBindingList{Items: []amsclient.RoleBinding{
+			{Id: strPtr("rb-1"), Account: &amsclient.ObjectReference{Id: strPtr("acc-1")}, Role: &amsclient.ObjectReference{Id: strPtr("OrganizationAdmin")}, Type: strPtr("Organization")},
+			{Id: strPtr("rb-2"), Account: &amsclient.ObjectReference{Id: strPtr("acc-2")}, Role: &amsclient.ObjectReference{Id: strPtr("ClusterProvisioner")}, Type: strPtr("Organization")},
+			{Id: strPtr("rb-3"), Account: &amsclient.ObjectReference{Id: strPtr("acc-1")}, Role: &amsclient.ObjectReference{Id: strPtr("ClusterProvisioner")}, Type: strPtr("Organization")},
+		}}, nil, nil
+	}
+
+	accountRequests := 0
+	mock.ApiAccountsMgmtV1AccountsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1AccountsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1AccountsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1AccountsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1AccountsGetRequest) (amsclient.AccountList, *http.Response, error) {
+		accountRequests++
+		return amsclient.AccountList{Items: []amsclient.Account{
+			{Id: strPtr("acc-1"), Username: "jdoe"},
+			{Id: strPtr("acc-2"), Username: "asmith"},
+		}}, nil, nil
+	}
+
+	bindings, err := ListRoleBindings(context.Background(), mock, "org-1", RoleBindingFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the usernames of all accounts are requested at once
+	if accountRequests != 1 {
+		t.Errorf("accounts were requested %v times, want 1", accountRequests)
+	}
+
+	want := []string{"jdoe", "asmith", "jdoe"}
+	if len(bindings) != len(want) {
+		t.Fatalf("ListRoleBindings() = %+v", bindings)
+	}
+	for i, b := range bindings {
+		if b.Username != want[i] || b.ID == "" || b.RoleID == "" {
+			t.Errorf("bindings[%v] = %+v, want username %q", i, b, want[i])
+		}
+	}
+}
+
+func TestListRoleBindingsPages(t *testing.T) {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAccountsMgmtV1RoleBindingsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest{ApiService: mock}
+	}
+
+	pages := [][]amsclient.RoleBinding{
+		{{Id: strPtr("rb-1"), Account: &amsclient.ObjectReference{Id: strPtr("acc-1")}}},
+		{{Id: strPtr("rb-2"), Account: &amsclient.ObjectReference{Id: strPtr("acc-1")}}},
+	}
+	requests := 0
+	mock.ApiAccountsMgmtV1RoleBindingsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest) (amsclient.RoleBindingList, *http.Response, error) {
+		requests++
+		return amsclient.RoleBindingList{Items: pages[requests-1], Total: int32(2)}, nil, nil
+	}
+	mock.ApiAccountsMgmtV1AccountsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1AccountsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1AccountsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1AccountsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1AccountsGetRequest) (amsclient.AccountList, *http.Response, error) {
+		return amsclient.AccountList{Items: []amsclient.Account{{Id: strPtr("acc-1"), Username: "jdoe"}}}, nil, nil
+	}
+
+	bindings, err := ListRoleBindings(context.Background(), mock, "org-1", RoleBindingFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 || len(bindings) != 2 || bindings[1].ID != "rb-2" {
+		t.Errorf("ListRoleBindings() = %+v after %v requests, want both pages", bindings, requests)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := map[string]string{
+		"jdoe":         "'jdoe'",
+		"x' or '1'='1": "'x'' or ''1''=''1'",
+		"":             "''",
+	}
+	for value, want := range tests {
+		if got := quote(value); got != want {
+			t.Errorf("quote(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// GetKafkaSubscription returns the subscription of the Kafka instance with the given ID
+func GetKafkaSubscription(ctx context.Context, api amsclient.DefaultApi, kafkaID string) (*amsclient.Subscription, error) {
+	subscriptions, _, err := api.ApiAccountsMgmtV1SubscriptionsGet(ctx).
+		Search(fmt.Sprintf("cluster_id='%v'", kafkaID)).
+		Size(1).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	items := subscriptions.GetItems()
+	if len(items) == 0 {
+		return nil, fmt.Errorf(`subscription of Kafka instance "%v" not found`, kafkaID)
+	}
+
+	return &items[0], nil
+}
@@ -0,0 +1,73 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// SupportProductKafka is the product of support cases about Kafka instances
+const SupportProductKafka = "Red Hat OpenShift Streams for Apache Kafka"
+
+// SupportCaseSeverities are the severities a support case can be opened with, from the lowest
+var SupportCaseSeverities = []string{"Low", "Normal", "High", "Urgent"}
+
+// SupportCase is a support case opened for a subscription
+type SupportCase = amsclient.SupportCasesCreatedResponse
+
+// CreateSupportCase opens a support case
+func CreateSupportCase(ctx context.Context, api amsclient.DefaultApi, request amsclient.SupportCasesRequest) (*SupportCase, error) {
+	supportCase, _, err := api.ApiAccountsMgmtV1SupportCasesPost(ctx).
+		SupportCasesRequest(request).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return &supportCase, nil
+}
+
+// ListSupportCases returns the support cases of the subscription with the given ID
+func ListSupportCases(ctx context.Context, api amsclient.DefaultApi, subscriptionID string) ([]SupportCase, error) {
+	// the client does not decode the cases, so they are read from the body of the response
+	resp, err := api.ApiAccountsMgmtV1SubscriptionsIdSupportCasesGet(ctx, subscriptionID).
+		Size(100).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSupportCases(body)
+}
+
+// decodeSupportCases decodes a JSON array of support cases, or a list with the cases as its items
+func decodeSupportCases(body []byte) ([]SupportCase, error) {
+	cases := []SupportCase{}
+	if len(body) == 0 {
+		return cases, nil
+	}
+
+	if err := json.Unmarshal(body, &cases); err == nil {
+		return cases, nil
+	}
+
+	var list struct {
+		Items []SupportCase `json:"items"`
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	if list.Items != nil {
+		cases = list.Items
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,28 @@
+package accountmgmtutil
+
+import "testing"
+
+func TestDecodeSupportCases(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{name: "array", body: `[{"caseNumber":"1"},{"caseNumber":"2"}]`, want: 2},
+		{name: "list", body: `{"items":[{"caseNumber":"1"}]}`, want: 1},
+		{name: "empty list", body: `{"items":null}`, want: 0},
+		{name: "empty body", body: ``, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cases, err := decodeSupportCases([]byte(tt.body))
+			if err != nil || cases == nil || len(cases) != tt.want {
+				t.Errorf("decodeSupportCases() = %+v, %v, want %v cases", cases, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := decodeSupportCases([]byte(`"not cases"`)); err == nil {
+		t.Error("decodeSupportCases() did not fail on an invalid body")
+	}
+}
@@ -0,0 +1,175 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+// UsageSample is the consumption of a subscription reported at a point in time
+type UsageSample struct {
+	SubscriptionID string
+	Time           time.Time
+	// CPU is the number of cores used
+	CPU float64
+	// Memory is the number of bytes of memory used
+	Memory float64
+}
+
+// SubscriptionProduct is the product and SKU consumed by a subscription
+type SubscriptionProduct struct {
+	Product string
+	SKU     string
+}
+
+// GetUsageSamples returns the consumption reported since the given time by the subscriptions with the given IDs.
+// The account management service only keeps the latest report of each subscription,
+// so there is at most one sample per subscription.
+func GetUsageSamples(ctx context.Context, api amsclient.DefaultApi, subscriptionIDs []string, since time.Time) ([]UsageSample, error) {
+	quoted := []string{}
+	for _, id := range subscriptionIDs {
+		quoted = append(quoted, quote(id))
+	}
+
+	samples := []UsageSample{}
+	// the metrics cannot be requested page by page, so they are requested for a page of subscriptions at a time
+	for start := 0; start < len(quoted); start += pageSize {
+		end := start + pageSize
+		if end > len(quoted) {
+			end = len(quoted)
+		}
+
+		metrics, _, err := api.ApiAccountsMgmtV1MetricsGet(ctx).
+			Search(fmt.Sprintf("id in (%v) and query_timestamp >= %v", strings.Join(quoted[start:end], ", "), quote(since.UTC().Format(time.RFC3339)))).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range metrics.GetItems() {
+			if m.QueryTimestamp == nil {
+				continue
+			}
+
+			values, err := decodeMetrics(m.GetMetrics())
+			if err != nil {
+				return nil, fmt.Errorf("unable to read the metrics of subscription %v: %w", m.GetId(), err)
+			}
+
+			sample := UsageSample{SubscriptionID: m.GetId(), Time: m.GetQueryTimestamp()}
+			for _, v := range values {
+				sample.CPU += v.Cpu.Used.Value
+				sample.Memory += v.Memory.Used.Value
+			}
+			samples = append(samples, sample)
+		}
+	}
+
+	return samples, nil
+}
+
+// decodeMetrics decodes the metrics of a subscription, which are a JSON document containing one metric or a list of them
+func decodeMetrics(metrics string) ([]amsclient.OneMetric, error) {
+	metrics = strings.TrimSpace(metrics)
+	if metrics == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(metrics, "[") {
+		var values []amsclient.OneMetric
+		err := json.Unmarshal([]byte(metrics), &values)
+		return values, err
+	}
+
+	var value amsclient.OneMetric
+	if err := json.Unmarshal([]byte(metrics), &value); err != nil {
+		return nil, err
+	}
+	return []amsclient.OneMetric{value}, nil
+}
+
+// GetSubscriptionProducts returns the product and SKU of the subscriptions of the organization by subscription ID.
+// The product is the plan of the subscription, and the SKU is the SKU of the quota its reserved resources are consumed from.
+// nolint:funlen
+func GetSubscriptionProducts(ctx context.Context, api amsclient.DefaultApi, orgID string) (map[string]SubscriptionProduct, error) {
+	products := map[string]SubscriptionProduct{}
+	for page, listed := int32(1), 0; ; page++ {
+		subscriptions, _, err := api.ApiAccountsMgmtV1SubscriptionsGet(ctx).
+			Search(fmt.Sprintf("organization_id=%v", quote(orgID))).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range subscriptions.GetItems() {
+			plan := s.GetPlan()
+			products[s.GetId()] = SubscriptionProduct{Product: plan.GetId()}
+		}
+
+		listed += len(subscriptions.GetItems())
+		if len(subscriptions.GetItems()) == 0 || listed >= int(subscriptions.GetTotal()) {
+			break
+		}
+	}
+	if len(products) == 0 {
+		return products, nil
+	}
+
+	skus := map[string]string{}
+	for page, listed := int32(1), 0; ; page++ {
+		resourceQuotas, _, err := api.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGet(ctx, orgID).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, q := range resourceQuotas.GetItems() {
+			skus[resourceKey(q.ResourceName, q.ResourceType, q.GetAvailabilityZoneType(), q.Byoc)] = q.GetSku()
+		}
+
+		listed += len(resourceQuotas.GetItems())
+		if len(resourceQuotas.GetItems()) == 0 || listed >= int(resourceQuotas.GetTotal()) {
+			break
+		}
+	}
+
+	for page, listed := int32(1), 0; ; page++ {
+		reservedResources, _, err := api.ApiAccountsMgmtV1ReservedResourcesGet(ctx).
+			Page(page).
+			Size(pageSize).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range reservedResources.GetItems() {
+			subscription := r.GetSubscription()
+			product, ok := products[subscription.GetId()]
+			if !ok {
+				continue
+			}
+			product.SKU = skus[resourceKey(r.GetResourceName(), r.GetResourceType(), r.GetAvailabilityZoneType(), r.Byoc)]
+			products[subscription.GetId()] = product
+		}
+
+		listed += len(reservedResources.GetItems())
+		if len(reservedResources.GetItems()) == 0 || listed >= int(reservedResources.GetTotal()) {
+			break
+		}
+	}
+
+	return products, nil
+}
+
+// resourceKey identifies the quota a resource is consumed from
+func resourceKey(name string, resourceType string, availabilityZoneType string, byoc bool) string {
+	return fmt.Sprintf("%v|%v|%v|%v", name, resourceType, availabilityZoneType, byoc)
+}
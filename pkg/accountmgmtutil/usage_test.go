@@ -0,0 +1,92 @@
+package accountmgmtutil
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+)
+
+func TestDecodeMetrics(t *testing.T) {
+	tests := []struct {
+		name    string
+		metrics string
+		cpu     []float64
+		wantErr bool
+	}{
+		{name: "empty", metrics: ""},
+		{name: "one metric", metrics: `{"cpu": {"used": {"unit": "", "value": 2}}}`, cpu: []float64{2}},
+		{name: "list of metrics", metrics: `[{"cpu": {"used": {"unit": "", "value": 1}}}, {"cpu": {"used": {"unit": "", "value": 3}}}]`, cpu: []float64{1, 3}},
+		{name: "invalid", metrics: `{`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := decodeMetrics(tt.metrics)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeMetrics() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(values) != len(tt.cpu) {
+				t.Fatalf("decodeMetrics() returned %v metrics, want %v", len(values), len(tt.cpu))
+			}
+			for i, v := range values {
+				if v.Cpu.Used.Value != tt.cpu[i] {
+					t.Errorf("metric %v has %v CPU cores used, want %v", i, v.Cpu.Used.Value, tt.cpu[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetSubscriptionProductsPages(t *testing.T) {
+	mock := &amsclient.DefaultApiMock{}
+
+	// the subscriptions are served one per page
+	subscriptions := []amsclient.Subscription{
+		{Id: strPtr("sub-1"), Plan: &amsclient.Plan{Id: strPtr("RHOSAK")}},
+		{Id: strPtr("sub-2"), Plan: &amsclient.Plan{Id: strPtr("RHOSAKTrial")}},
+	}
+	mock.ApiAccountsMgmtV1SubscriptionsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1SubscriptionsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1SubscriptionsGetRequest) (amsclient.SubscriptionList, *http.Response, error) {
+		page := len(mock.ApiAccountsMgmtV1SubscriptionsGetExecuteCalls()) - 1
+		return amsclient.SubscriptionList{Items: subscriptions[page : page+1], Total: int32(len(subscriptions))}, nil, nil
+	}
+
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetFunc = func(ctx context.Context, orgID string) amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest) (amsclient.ResourceQuotaList, *http.Response, error) {
+		return amsclient.ResourceQuotaList{Items: []amsclient.ResourceQuota{
+			{ResourceName: "rhosak", ResourceType: "cluster.aws", Sku: strPtr("RH00001")},
+		}, Total: 1}, nil, nil
+	}
+
+	mock.ApiAccountsMgmtV1ReservedResourcesGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1ReservedResourcesGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1ReservedResourcesGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1ReservedResourcesGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1ReservedResourcesGetRequest) (amsclient.ReservedResourceList, *http.Response, error) {
+		return amsclient.ReservedResourceList{Items: []amsclient.ReservedResource{
+			{ResourceName: strPtr("rhosak"), ResourceType: strPtr("cluster.aws"), Subscription: &amsclient.ObjectReference{Id: strPtr("sub-2")}},
+		}, Total: 1}, nil, nil
+	}
+
+	products, err := GetSubscriptionProducts(context.Background(), mock, "org-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]SubscriptionProduct{
+		"sub-1": {Product: "RHOSAK"},
+		"sub-2": {Product: "RHOSAKTrial", SKU: "RH00001"},
+	}
+	if len(products) != len(want) {
+		t.Fatalf("GetSubscriptionProducts() = %v, want %v", products, want)
+	}
+	for id, p := range want {
+		if products[id] != p {
+			t.Errorf("products[%v] = %+v, want %+v", id, products[id], p)
+		}
+	}
+}
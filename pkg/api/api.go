@@ -2,14 +2,20 @@ package api
 
 import (
 	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
 )
 
 // API is a type which defines a number of API creator functions
 type API struct {
-	Kafka          func() kafkamgmtclient.DefaultApi
-	ServiceAccount func() kafkamgmtclient.SecurityApi
-	KafkaAdmin     func(kafkaID string) (kafkainstanceclient.DefaultApi, *kafkamgmtclient.KafkaRequest, error)
-	AccountMgmt    func() amsclient.DefaultApi
+	Kafka                   func() kafkamgmtclient.DefaultApi
+	ServiceAccount          func() kafkamgmtclient.SecurityApi
+	KafkaAdmin              func(kafkaID string) (kafkainstanceclient.DefaultApi, *kafkamgmtclient.KafkaRequest, error)
+	KafkaDataPlane          func(kafkaID string) (*dataplane.Config, *kafkamgmtclient.KafkaRequest, error)
+	ServiceRegistryMgmt     func() srsmgmtclient.RegistriesApi
+	ServiceRegistryArtifact func(registryID string) (*artifact.Client, *srsmgmtclient.Registry, error)
+	AccountMgmt             func() amsclient.DefaultApi
 }
@@ -118,7 +118,10 @@ func GetExpiry(tokenStr string, now time.Time) (expires bool,
 }
 
 func GetUsername(tokenStr string) (username string, ok bool) {
-	accessTkn, _ := Parse(tokenStr)
+	accessTkn, err := Parse(tokenStr)
+	if err != nil {
+		return "", false
+	}
 	tknClaims, _ := MapClaims(accessTkn)
 	userName, ok := tknClaims["preferred_username"]
 	if ok {
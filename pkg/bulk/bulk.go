@@ -0,0 +1,179 @@
+// Package bulk contains functions to select and process multiple resources in one command
+package bulk
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+)
+
+const (
+	// DefaultConcurrency is the maximum number of items processed at the same time
+	DefaultConcurrency = 5
+	// StdinArg is the argument used to read the list of items from standard input
+	StdinArg = "-"
+)
+
+// Result is the outcome of processing a single item
+type Result struct {
+	Item string
+	Err  error
+}
+
+// resultRow contains the properties used to
+// print the result of processing an item in a table row
+type resultRow struct {
+	Item   string `header:"Resource"`
+	Status string `header:"Status"`
+	Error  string `header:"Error"`
+}
+
+// NewMatcher creates a function which reports whether a value matches
+// the glob pattern or the regular expression. Only one of them may be set.
+// When neither is set, the matcher returns nil.
+func NewMatcher(localizer localize.Localizer, pattern string, regex string) (func(string) bool, error) {
+	switch {
+	case pattern != "" && regex != "":
+		return nil, errors.New(localizer.MustLocalize("common.bulk.error.patternAndRegex"))
+	case pattern != "":
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.New(localizer.MustLocalize("common.bulk.error.invalidPattern",
+				localize.NewEntry("Pattern", pattern),
+				localize.NewEntry("ErrorMessage", err),
+			))
+		}
+		return func(s string) bool {
+			matched, _ := path.Match(pattern, s)
+			return matched
+		}, nil
+	case regex != "":
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return nil, errors.New(localizer.MustLocalize("common.bulk.error.invalidRegex",
+				localize.NewEntry("Regex", regex),
+				localize.NewEntry("ErrorMessage", err),
+			))
+		}
+		return re.MatchString, nil
+	default:
+		return nil, nil
+	}
+}
+
+// ReadItems reads a list of items from r, separated by whitespace or new lines.
+// Lines starting with "#" are ignored.
+func ReadItems(r io.Reader) ([]string, error) {
+	items := []string{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		items = append(items, strings.Fields(line)...)
+	}
+
+	return items, scanner.Err()
+}
+
+// CollectArgs returns the items passed as arguments.
+// When an argument is "-", the items are read from in.
+func CollectArgs(args []string, in io.Reader) ([]string, error) {
+	items := []string{}
+	for _, arg := range args {
+		if arg != StdinArg {
+			items = append(items, arg)
+			continue
+		}
+		stdinItems, err := ReadItems(in)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, stdinItems...)
+	}
+
+	return items, nil
+}
+
+// Unique removes duplicate items, preserving the original order
+func Unique(items []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, item := range items {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		unique = append(unique, item)
+	}
+
+	return unique
+}
+
+// Run calls fn for every item, processing at most `concurrency` items at the same time.
+// The results are returned in the same order as the items.
+func Run(items []string, concurrency int, fn func(item string) error) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]Result, len(items))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = Result{Item: items[i], Err: fn(items[i])}
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// Failed returns the number of results with an error
+func Failed(results []Result) int {
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// PrintSummary prints the result of every item in a table
+// successStatus is the localized status printed for items which were processed without error
+func PrintSummary(w io.Writer, localizer localize.Localizer, results []Result, successStatus string) {
+	rows := []resultRow{}
+	for _, r := range results {
+		row := resultRow{
+			Item:   r.Item,
+			Status: successStatus,
+		}
+		if r.Err != nil {
+			row.Status = localizer.MustLocalize("common.bulk.status.failed")
+			row.Error = r.Err.Error()
+		}
+		rows = append(rows, row)
+	}
+
+	dump.Table(w, rows)
+}
@@ -0,0 +1,149 @@
+package bulk
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/localize/goi18n"
+)
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewMatcher(t *testing.T) {
+	localizer, _ := goi18n.New(nil)
+
+	tests := []struct {
+		name    string
+		pattern string
+		regex   string
+		value   string
+		want    bool
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "matches a glob pattern",
+			pattern: "test-*",
+			value:   "test-orders",
+			want:    true,
+		},
+		{
+			name:    "does not match a glob pattern",
+			pattern: "test-*",
+			value:   "orders",
+			want:    false,
+		},
+		{
+			name:  "matches a regular expression",
+			regex: "^orders-v[0-9]+$",
+			value: "orders-v2",
+			want:  true,
+		},
+		{
+			name:    "returns nil when nothing is set",
+			wantNil: true,
+		},
+		{
+			name:    "fails when both are set",
+			pattern: "test-*",
+			regex:   "test-.*",
+			wantErr: true,
+		},
+		{
+			name:    "fails on an invalid regular expression",
+			regex:   "orders-(",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := NewMatcher(localizer, tt.pattern, tt.regex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMatcher() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if (matches == nil) != tt.wantNil {
+				t.Errorf("NewMatcher() returned nil = %v, want %v", matches == nil, tt.wantNil)
+				return
+			}
+			if matches != nil && matches(tt.value) != tt.want {
+				t.Errorf("matches(%v) = %v, want %v", tt.value, !tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectArgs(t *testing.T) {
+	stdin := strings.NewReader("topic-2\n\n# a comment\ntopic-3 topic-4\n")
+
+	got, err := CollectArgs([]string{"topic-1", StdinArg}, stdin)
+	if err != nil {
+		t.Fatalf("CollectArgs() error = %v", err)
+	}
+
+	want := []string{"topic-1", "topic-2", "topic-3", "topic-4"}
+	if !equal(got, want) {
+		t.Errorf("CollectArgs() = %v, want %v", got, want)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique([]string{"a", "b", "a", "c", "b"})
+	want := []string{"a", "b", "c"}
+	if !equal(got, want) {
+		t.Errorf("Unique() = %v, want %v", got, want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e", "f", "g"}
+
+	var running, maxRunning int32
+	results := Run(items, 3, func(item string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		if item == "c" || item == "f" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("Run() processed %v items at the same time, want at most 3", maxRunning)
+	}
+
+	if len(results) != len(items) {
+		t.Fatalf("Run() returned %v results, want %v", len(results), len(items))
+	}
+	for i, r := range results {
+		if r.Item != items[i] {
+			t.Errorf("Run() result %v is for item %v, want %v", i, r.Item, items[i])
+		}
+	}
+
+	if failed := Failed(results); failed != 2 {
+		t.Errorf("Failed() = %v, want 2", failed)
+	}
+}
@@ -0,0 +1,144 @@
+// Package cache provides an on-disk cache of slow-changing control plane responses,
+// such as cloud providers and the names of instances and topics
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// CloudProvidersTTL is how long cloud providers and their regions are cached
+	CloudProvidersTTL = 24 * time.Hour
+	// NamesTTL is how long the names of instances and topics are cached
+	NamesTTL = 5 * time.Minute
+	// FeatureTogglesTTL is how long the feature toggles of the organization are cached
+	FeatureTogglesTTL = time.Hour
+)
+
+// CloudProvidersKey is the key of the cached cloud providers
+const CloudProvidersKey = "cloud_providers"
+
+// FeatureTogglesKey is the key of the cached feature toggles
+const FeatureTogglesKey = "feature_toggles"
+
+// CloudRegionsKey returns the key of the cached regions of a cloud provider
+func CloudRegionsKey(providerID string) string {
+	return "cloud_regions/" + providerID
+}
+
+// InstancesKey returns the key of the cached instance names of a service
+func InstancesKey(serviceName string) string {
+	return "instances/" + serviceName
+}
+
+// TopicsKey returns the key of the cached topic names of a Kafka instance
+func TopicsKey(kafkaID string) string {
+	return "topics/" + kafkaID
+}
+
+// Cache stores responses for a single API URL and account.
+// A nil Cache is valid and caches nothing.
+type Cache struct {
+	dir string
+	now func() time.Time
+}
+
+// entry is a cached response
+type entry struct {
+	Created time.Time       `json:"created"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// New creates a cache in the directory for the API URL and account within dir
+func New(dir string, apiURL string, account string) *Cache {
+	sum := sha256.Sum256([]byte(apiURL + "\n" + account))
+	return &Cache{
+		dir: filepath.Join(dir, "responses", hex.EncodeToString(sum[:8])),
+		now: time.Now,
+	}
+}
+
+// DefaultDir returns the default directory of the caches of the CLI
+func DefaultDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userCacheDir, "rhoas"), nil
+}
+
+// Clear removes all the caches in dir
+func Clear(dir string) error {
+	return os.RemoveAll(dir)
+}
+
+// Get reads the value cached for key into v.
+// It returns false if there is no value, or if it is older than ttl.
+func (c *Cache) Get(key string, ttl time.Duration, v interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+
+	var e entry
+	if err = json.Unmarshal(data, &e); err != nil {
+		return false
+	}
+
+	if c.now().Sub(e.Created) > ttl {
+		return false
+	}
+
+	return json.Unmarshal(e.Data, v) == nil
+}
+
+// Set caches v for key
+func (c *Cache) Set(key string, v interface{}) error {
+	if c == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry{Created: c.now(), Data: value})
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path(key), data, 0600)
+}
+
+// Remove removes the value cached for key, so that it is fetched again
+func (c *Cache) Remove(key string) error {
+	if c == nil {
+		return nil
+	}
+
+	err := os.Remove(c.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, url.PathEscape(key)+".json")
+}
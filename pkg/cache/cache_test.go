@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	c := New(dir, "https://api.openshift.com", "alice")
+	c.now = func() time.Time { return now }
+
+	var names []string
+	if c.Get(TopicsKey("abc"), NamesTTL, &names) {
+		t.Error("Get() found a value in an empty cache")
+	}
+
+	if err = c.Set(TopicsKey("abc"), []string{"orders", "payments"}); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Get(TopicsKey("abc"), NamesTTL, &names) || !reflect.DeepEqual(names, []string{"orders", "payments"}) {
+		t.Errorf("Get() = %v", names)
+	}
+
+	// other accounts and API URLs have their own cache
+	for _, other := range []*Cache{New(dir, "https://api.openshift.com", "bob"), New(dir, "https://api.stage.openshift.com", "alice")} {
+		if other.Get(TopicsKey("abc"), NamesTTL, &names) {
+			t.Errorf("Get() found a value of another cache in %v", other.dir)
+		}
+	}
+
+	// values expire after their time to live
+	now = now.Add(NamesTTL + time.Second)
+	if c.Get(TopicsKey("abc"), NamesTTL, &names) {
+		t.Error("Get() returned an expired value")
+	}
+
+	if err = c.Set(InstancesKey("kafka"), []string{"my-kafka"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Remove(InstancesKey("kafka")); err != nil {
+		t.Fatal(err)
+	}
+	if c.Get(InstancesKey("kafka"), NamesTTL, &names) {
+		t.Error("Get() returned a removed value")
+	}
+	if err = c.Remove(InstancesKey("kafka")); err != nil {
+		t.Errorf("Remove() failed for a missing value: %v", err)
+	}
+
+	if err = Clear(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("Clear() did not remove the cache: %v", err)
+	}
+
+	// a nil cache caches nothing
+	var disabled *Cache
+	if err = disabled.Set(CloudProvidersKey, []string{"aws"}); err != nil || disabled.Get(CloudProvidersKey, CloudProvidersTTL, &names) {
+		t.Error("a nil cache cached a value")
+	}
+}
@@ -0,0 +1,23 @@
+// This file contains functions used to implement the '--no-cache' command line option.
+
+package cache
+
+import "github.com/spf13/pflag"
+
+// AddFlag adds the '--no-cache' flag to the given set of command line flags
+func AddFlag(fs *pflag.FlagSet, description string) {
+	fs.BoolVar(
+		&disabled,
+		"no-cache",
+		false,
+		description,
+	)
+}
+
+// Disabled returns true if responses must not be read from or written to the cache
+func Disabled() bool {
+	return disabled
+}
+
+// disabled is a boolean flag that indicates that the cache is disabled
+var disabled bool
@@ -1,6 +1,9 @@
 package cloudproviderutil
 
 import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 )
 
@@ -24,3 +27,40 @@ func FindByName(cloudProviders []kafkamgmtclient.CloudProvider, name string) *ka
 	}
 	return nil
 }
+
+// FindByID finds and returns a cloud provider item from the list by its ID
+func FindByID(cloudProviders []kafkamgmtclient.CloudProvider, id string) *kafkamgmtclient.CloudProvider {
+	for _, p := range cloudProviders {
+		if p.GetId() == id {
+			return &p
+		}
+	}
+	return nil
+}
+
+// GetIDs returns the IDs of all the cloud providers
+func GetIDs(cloudProviders []kafkamgmtclient.CloudProvider) []string {
+	var ids = []string{}
+	for _, provider := range cloudProviders {
+		ids = append(ids, provider.GetId())
+	}
+	return ids
+}
+
+// List returns the cloud providers, reading them from c when they are cached
+func List(ctx context.Context, api kafkamgmtclient.DefaultApi, c *cache.Cache) ([]kafkamgmtclient.CloudProvider, error) {
+	var cloudProviders []kafkamgmtclient.CloudProvider
+	if c.Get(cache.CloudProvidersKey, cache.CloudProvidersTTL, &cloudProviders) {
+		return cloudProviders, nil
+	}
+
+	cloudProviderResponse, _, err := api.GetCloudProviders(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProviders = cloudProviderResponse.GetItems()
+	_ = c.Set(cache.CloudProvidersKey, cloudProviders)
+
+	return cloudProviders, nil
+}
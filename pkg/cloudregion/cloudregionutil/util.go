@@ -1,6 +1,9 @@
 package cloudregionutil
 
 import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 )
 
@@ -14,3 +17,21 @@ func GetEnabledIDs(regions []kafkamgmtclient.CloudRegion) []string {
 	}
 	return regionIDs
 }
+
+// List returns the regions of a cloud provider, reading them from c when they are cached
+func List(ctx context.Context, api kafkamgmtclient.DefaultApi, c *cache.Cache, providerID string) ([]kafkamgmtclient.CloudRegion, error) {
+	var regions []kafkamgmtclient.CloudRegion
+	if c.Get(cache.CloudRegionsKey(providerID), cache.CloudProvidersTTL, &regions) {
+		return regions, nil
+	}
+
+	cloudRegionResponse, _, err := api.GetCloudProviderRegions(ctx, providerID).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	regions = cloudRegionResponse.GetItems()
+	_ = c.Set(cache.CloudRegionsKey(providerID), regions)
+
+	return regions, nil
+}
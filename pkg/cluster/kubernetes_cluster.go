@@ -314,7 +314,8 @@ func (c *KubernetesCluster) createServiceAccount(ctx context.Context) (*kafkamgm
 	t := time.Now()
 
 	api := c.connection.API()
-	serviceAcct := &kafkamgmtclient.ServiceAccountRequest{Name: fmt.Sprintf("rhoascli-%v", t.Unix())}
+	description := c.localizer.MustLocalize("cluster.kubernetes.createServiceAccount.description")
+	serviceAcct := &kafkamgmtclient.ServiceAccountRequest{Name: fmt.Sprintf("rhoascli-%v", t.Unix()), Description: &description}
 	req := api.ServiceAccount().CreateServiceAccount(ctx)
 	req = req.ServiceAccountRequest(*serviceAcct)
 	res, _, err := req.Execute()
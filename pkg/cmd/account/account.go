@@ -0,0 +1,23 @@
+// Package account contains commands for viewing the account of the user
+package account
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/account/show"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/spf13/cobra"
+)
+
+func NewAccountCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("account.cmd.use"),
+		Short: f.Localizer.MustLocalize("account.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("account.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		show.NewShowCommand(f),
+	)
+
+	return cmd
+}
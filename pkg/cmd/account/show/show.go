@@ -0,0 +1,150 @@
+package show
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// accountDetails is the current account and its organization
+type accountDetails struct {
+	ID                     string     `json:"id" yaml:"id"`
+	Username               string     `json:"username" yaml:"username"`
+	Email                  string     `json:"email,omitempty" yaml:"email,omitempty"`
+	FirstName              string     `json:"first_name,omitempty" yaml:"first_name,omitempty"`
+	LastName               string     `json:"last_name,omitempty" yaml:"last_name,omitempty"`
+	OrganizationID         string     `json:"organization_id" yaml:"organization_id"`
+	OrganizationName       string     `json:"organization_name,omitempty" yaml:"organization_name,omitempty"`
+	ExternalOrganizationID string     `json:"external_organization_id,omitempty" yaml:"external_organization_id,omitempty"`
+	Roles                  []string   `json:"roles" yaml:"roles"`
+	CreatedAt              *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// field is a value of the account printed to a table
+type field struct {
+	Name  string `header:"Name"`
+	Value string `header:"Value"`
+}
+
+type Options struct {
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewShowCommand creates a command to view the current account
+func NewShowCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("account.show.cmd.use"),
+		Short:   opts.localizer.MustLocalize("account.show.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("account.show.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("account.show.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runShow(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("account.show.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runShow(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	account, _, err := api.ApiAccountsMgmtV1CurrentAccountGet(ctx).Execute()
+	if err != nil {
+		return err
+	}
+
+	org := account.GetOrganization()
+	details := accountDetails{
+		ID:                     account.GetId(),
+		Username:               account.GetUsername(),
+		Email:                  account.GetEmail(),
+		FirstName:              account.GetFirstName(),
+		LastName:               account.GetLastName(),
+		OrganizationID:         org.GetId(),
+		OrganizationName:       org.GetName(),
+		ExternalOrganizationID: org.GetExternalId(),
+		CreatedAt:              account.CreatedAt,
+	}
+
+	// the roles are only visible to some users, so the account is shown without them otherwise
+	details.Roles, err = accountmgmtutil.GetAccountRoles(ctx, api, details.ID)
+	if err != nil {
+		logger.Debug(opts.localizer.MustLocalize("account.show.log.debug.rolesNotAvailable", localize.NewEntry("Error", err)))
+		details.Roles = []string{}
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(details)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(details)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, mapDetailsToFields(opts.localizer, details))
+	}
+
+	return nil
+}
+
+func mapDetailsToFields(localizer localize.Localizer, d accountDetails) []field {
+	fields := []field{
+		{Name: localizer.MustLocalize("account.show.field.id"), Value: d.ID},
+		{Name: localizer.MustLocalize("account.show.field.username"), Value: d.Username},
+		{Name: localizer.MustLocalize("account.show.field.email"), Value: d.Email},
+		{Name: localizer.MustLocalize("account.show.field.name"), Value: strings.TrimSpace(d.FirstName + " " + d.LastName)},
+		{Name: localizer.MustLocalize("account.show.field.organizationID"), Value: d.OrganizationID},
+		{Name: localizer.MustLocalize("account.show.field.organization"), Value: d.OrganizationName},
+		{Name: localizer.MustLocalize("account.show.field.externalOrganizationID"), Value: d.ExternalOrganizationID},
+		{Name: localizer.MustLocalize("account.show.field.roles"), Value: strings.Join(d.Roles, ", ")},
+	}
+	if d.CreatedAt != nil {
+		fields = append(fields, field{Name: localizer.MustLocalize("account.show.field.created"), Value: d.CreatedAt.Format(time.RFC3339)})
+	}
+	return fields
+}
@@ -0,0 +1,100 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+)
+
+func newAccountMgmtMock(rolesErr error) *amsclient.DefaultApiMock {
+	accountID, email, orgID, orgName, externalID := "acct-1", "jdoe@example.com", "org-1", "Example Inc.", "12345"
+	roleIDs := []string{"OrganizationAdmin", "ClusterEditor", "OrganizationAdmin"}
+
+	mock := testutil.NewAccountMgmtMock(amsclient.Account{
+		Id:           &accountID,
+		Username:     "jdoe",
+		Email:        &email,
+		Organization: &amsclient.Organization{Id: &orgID, Name: &orgName, ExternalId: &externalID},
+	})
+	mock.ApiAccountsMgmtV1RoleBindingsGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1RoleBindingsGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1RoleBindingsGetRequest) (amsclient.RoleBindingList, *http.Response, error) {
+		if rolesErr != nil {
+			return amsclient.RoleBindingList{}, nil, rolesErr
+		}
+		bindings := []amsclient.RoleBinding{}
+		for i := range roleIDs {
+			bindings = append(bindings, amsclient.RoleBinding{Role: &amsclient.ObjectReference{Id: &roleIDs[i]}})
+		}
+		return amsclient.RoleBindingList{Items: bindings}, nil, nil
+	}
+	return mock
+}
+
+func TestShowCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		rolesErr  error
+		wantRoles []string
+		wantTable []string
+	}{
+		{
+			name:      "table",
+			wantTable: []string{"jdoe@example.com", "Example Inc.", "12345", "OrganizationAdmin, ClusterEditor"},
+		},
+		{
+			name:      "json",
+			args:      []string{"-o", "json"},
+			wantRoles: []string{"OrganizationAdmin", "ClusterEditor"},
+		},
+		{
+			name:      "roles are not available",
+			args:      []string{"-o", "json"},
+			rolesErr:  errors.New("forbidden"),
+			wantRoles: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newAccountMgmtMock(tt.rolesErr)
+			var out bytes.Buffer
+			f := testutil.NewAccountMgmtFactory(mock, &out)
+
+			cmd := NewShowCommand(f)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range tt.wantTable {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output does not contain %q:\n%v", want, out.String())
+				}
+			}
+
+			if tt.wantRoles != nil {
+				var details accountDetails
+				if err := json.Unmarshal(out.Bytes(), &details); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out.String(), err)
+				}
+				if details.Username != "jdoe" || details.OrganizationID != "org-1" || details.ExternalOrganizationID != "12345" {
+					t.Errorf("account = %+v", details)
+				}
+				if strings.Join(details.Roles, ",") != strings.Join(tt.wantRoles, ",") {
+					t.Errorf("roles = %v, want %v", details.Roles, tt.wantRoles)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package auth contains commands for checking the permissions of the current account
+package auth
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/auth/cani"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/spf13/cobra"
+)
+
+func NewAuthCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("auth.cmd.use"),
+		Short: f.Localizer.MustLocalize("auth.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("auth.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		cani.NewCanICommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,167 @@
+package cani
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	action         string
+	resourceType   string
+	subscriptionID string
+	clusterID      string
+	list           bool
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewCanICommand creates a command to check if the current account is allowed to perform an action
+func NewCanICommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("auth.canI.cmd.use"),
+		Short:   opts.localizer.MustLocalize("auth.canI.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("auth.canI.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("auth.canI.cmd.example"),
+		Args:    cobra.ExactArgs(2),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch len(args) {
+			case 0:
+				return accountmgmtutil.AccessReviewActions, cobra.ShellCompDirectiveNoFileComp
+			case 1:
+				return accountmgmtutil.AccessReviewResourceTypes, cobra.ShellCompDirectiveNoFileComp
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.action = strings.ToLower(args[0])
+
+			validActions := accountmgmtutil.AccessReviewActions
+			validTypes := accountmgmtutil.AccessReviewResourceTypes
+			if opts.list {
+				if opts.subscriptionID != "" || opts.clusterID != "" {
+					return errors.New(opts.localizer.MustLocalize("auth.canI.error.listWithResource"))
+				}
+				validActions = accountmgmtutil.ResourceReviewActions
+				validTypes = accountmgmtutil.ResourceReviewResourceTypes
+			}
+
+			if !flagutil.IsValidInput(opts.action, validActions...) {
+				return errors.New(opts.localizer.MustLocalize("auth.canI.error.invalidAction",
+					localize.NewEntry("Action", args[0]),
+					localize.NewEntry("ValidActions", strings.Join(validActions, ", ")),
+				))
+			}
+
+			resourceType, ok := accountmgmtutil.FindResourceType(validTypes, args[1])
+			if !ok {
+				return errors.New(opts.localizer.MustLocalize("auth.canI.error.invalidResourceType",
+					localize.NewEntry("ResourceType", args[1]),
+					localize.NewEntry("ValidResourceTypes", strings.Join(validTypes, ", ")),
+				))
+			}
+			opts.resourceType = resourceType
+
+			if opts.list {
+				return runList(opts)
+			}
+
+			return runCanI(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.subscriptionID, "subscription", "", opts.localizer.MustLocalize("auth.canI.flag.subscription.description"))
+	cmd.Flags().StringVar(&opts.clusterID, "cluster", "", opts.localizer.MustLocalize("auth.canI.flag.cluster.description"))
+	cmd.Flags().BoolVar(&opts.list, "list", false, opts.localizer.MustLocalize("auth.canI.flag.list.description"))
+
+	return cmd
+}
+
+func runCanI(opts *Options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := accountmgmtutil.ReviewAccess(ctx, api, accountmgmtutil.AccessReview{
+		Action:         opts.action,
+		ResourceType:   opts.resourceType,
+		OrganizationID: orgID,
+		SubscriptionID: opts.subscriptionID,
+		ClusterID:      opts.clusterID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if allowed {
+		fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("auth.canI.output.allowed"))
+		return nil
+	}
+
+	// the answer is printed for people, and the error gives scripts a non-zero exit code
+	fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("auth.canI.output.notAllowed"))
+	return errors.New(opts.localizer.MustLocalize("auth.canI.error.notAllowed",
+		localize.NewEntry("Action", opts.action),
+		localize.NewEntry("ResourceType", opts.resourceType),
+	))
+}
+
+func runList(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	ids, err := accountmgmtutil.ListAllowedResources(context.Background(), conn.API().AccountMgmt(), opts.action, opts.resourceType)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		logger.Info(opts.localizer.MustLocalize("auth.canI.log.info.noResources",
+			localize.NewEntry("Action", opts.action),
+			localize.NewEntry("ResourceType", opts.resourceType),
+		))
+		return nil
+	}
+
+	for _, id := range ids {
+		fmt.Fprintln(opts.IO.Out, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+// Package cache contains commands for managing the local cache of the CLI
+package cache
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/cache/clear"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/spf13/cobra"
+)
+
+func NewCacheCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("cache.cmd.use"),
+		Short: f.Localizer.MustLocalize("cache.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("cache.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		clear.NewClearCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package clear
+
+import (
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	Logger    func() (logging.Logger, error)
+	localizer localize.Localizer
+
+	// Dir returns the directory of the caches
+	Dir func() (string, error)
+}
+
+// NewClearCommand creates a command to remove all cached responses
+func NewClearCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Logger:    f.Logger,
+		localizer: f.Localizer,
+		Dir:       cache.DefaultDir,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("cache.clear.cmd.use"),
+		Short:   opts.localizer.MustLocalize("cache.clear.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("cache.clear.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("cache.clear.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClear(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runClear(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	dir, err := opts.Dir()
+	if err != nil {
+		return err
+	}
+
+	if err = cache.Clear(dir); err != nil {
+		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("cache.clear.error.clearError"), err)
+	}
+
+	logger.Info(opts.localizer.MustLocalize("cache.clear.log.info.clearSuccess"))
+
+	return nil
+}
@@ -88,7 +88,7 @@ func runBind(opts *Options) error {
 	}
 
 	// In future config will include Id's of other services
-	if cfg.Services.Kafka == nil || opts.ignoreContext {
+	if !cfg.HasKafka() || opts.ignoreContext {
 		// nolint:govet
 		selectedKafka, err := kafka.InteractiveSelect(apiConnection, logger)
 		if err != nil {
@@ -86,7 +86,7 @@ func runConnect(opts *Options) error {
 	}
 
 	// In future config will include Id's of other services
-	if cfg.Services.Kafka == nil || opts.ignoreContext {
+	if !cfg.HasKafka() || opts.ignoreContext {
 		// nolint
 		selectedKafka, err := kafka.InteractiveSelect(connection, logger)
 		if err != nil {
@@ -0,0 +1,23 @@
+// Package context contains commands for inspecting the project context
+package context
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context/show"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/spf13/cobra"
+)
+
+func NewContextCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("context.cmd.use"),
+		Short: f.Localizer.MustLocalize("context.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("context.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		show.NewShowCommand(f),
+	)
+
+	return cmd
+}
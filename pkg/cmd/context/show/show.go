@@ -0,0 +1,140 @@
+package show
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	kafkaflags "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// sources of the context values
+const (
+	sourceFlag    = "flag"
+	sourceProject = "project"
+	sourceUser    = "user"
+	sourceNone    = "none"
+)
+
+// contextValue is a value of the context and where it came from
+type contextValue struct {
+	Name   string `json:"name" yaml:"name" header:"Name"`
+	Value  string `json:"value,omitempty" yaml:"value,omitempty" header:"Value"`
+	Source string `json:"source" yaml:"source" header:"Source"`
+	Path   string `json:"path,omitempty" yaml:"path,omitempty" header:"Path"`
+}
+
+type Options struct {
+	outputFormat string
+
+	IO        *iostreams.IOStreams
+	Config    config.IConfig
+	localizer localize.Localizer
+}
+
+// NewShowCommand creates a command to show the context used in the working directory
+func NewShowCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:        f.IOStreams,
+		Config:    f.Config,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("context.show.cmd.use"),
+		Short:   opts.localizer.MustLocalize("context.show.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("context.show.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("context.show.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			return runShow(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("context.show.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runShow(opts *Options) error {
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	userPath, err := opts.Config.Location()
+	if err != nil {
+		return err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	local, localPath, err := config.LoadLocal(wd)
+	if err != nil {
+		return err
+	}
+
+	// newValue explains where the value came from, the --kafka flag and the project context
+	// taking precedence over the user configuration
+	newValue := func(name, value string, setByFlag, setLocally bool) contextValue {
+		switch {
+		case setByFlag:
+			return contextValue{Name: name, Value: value, Source: sourceFlag}
+		case setLocally:
+			return contextValue{Name: name, Value: value, Source: sourceProject, Path: localPath}
+		case value != "":
+			return contextValue{Name: name, Value: value, Source: sourceUser, Path: userPath}
+		default:
+			return contextValue{Name: name, Source: sourceNone}
+		}
+	}
+
+	var kafkaID, registryID, serviceAccountID string
+	if cfg.HasKafka() {
+		kafkaID = cfg.Services.Kafka.ClusterID
+	}
+	if cfg.HasRegistry() {
+		registryID = cfg.Services.Registry.InstanceID
+	}
+	if cfg.HasServiceAccount() {
+		serviceAccountID = cfg.Services.ServiceAccount.ID
+	}
+
+	values := []contextValue{
+		newValue("kafka", kafkaID, kafkaflags.Instance() != "", local.HasKafka()),
+		newValue("kafka.topic", cfg.DefaultTopic(), false, local.HasTopic()),
+		newValue("serviceaccount", serviceAccountID, false, local.HasServiceAccount()),
+		newValue("serviceregistry", registryID, false, local.HasRegistry()),
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(values)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(values)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, values)
+	}
+
+	return nil
+}
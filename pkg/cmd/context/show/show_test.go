@@ -0,0 +1,81 @@
+package show
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	kafkaflags "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+	"github.com/spf13/pflag"
+)
+
+func TestShowKafkaSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-show")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd) // nolint
+
+	flags := pflag.NewFlagSet("rhoas", pflag.ContinueOnError)
+	kafkaflags.AddInstanceFlag(flags, "")
+	defer flags.Set(kafkaflags.FlagKafka, "") // nolint
+
+	tests := []struct {
+		name       string
+		kafka      string
+		wantSource string
+		wantPath   string
+	}{
+		{name: "the user configuration is used without the --kafka flag", wantSource: sourceUser, wantPath: "/home/user/.config/rhoas/config.json"},
+		{name: "the --kafka flag takes precedence", kafka: "my-kafka", wantSource: sourceFlag},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			if err := flags.Set(kafkaflags.FlagKafka, tt.kafka); err != nil {
+				t.Fatal(err)
+			}
+
+			out := &bytes.Buffer{}
+			f := testutil.NewKafkaMgmtFactory(nil, out)
+			f.Config = &config.IConfigMock{
+				LoadFunc: func() (*config.Config, error) {
+					return &config.Config{
+						Services: config.ServiceConfigMap{Kafka: &config.KafkaConfig{ClusterID: "kafka-id"}},
+					}, nil
+				},
+				LocationFunc: func() (string, error) {
+					return "/home/user/.config/rhoas/config.json", nil
+				},
+			}
+
+			cmd := NewShowCommand(f)
+			cmd.SetArgs([]string{"-o", "json"})
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			var values []contextValue
+			if err := json.Unmarshal(out.Bytes(), &values); err != nil {
+				t.Fatal(err)
+			}
+			got := values[0]
+			if got.Name != "kafka" || got.Value != "kafka-id" || got.Source != tt.wantSource || got.Path != tt.wantPath {
+				t.Errorf("kafka = %+v, want source %q and path %q", got, tt.wantSource, tt.wantPath)
+			}
+		})
+	}
+}
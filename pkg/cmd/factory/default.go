@@ -3,15 +3,22 @@ package factory
 import (
 	"context"
 	"net/http"
+	"os"
 
 	"github.com/redhat-developer/app-services-cli/internal/build"
 	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/auth/token"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/debug"
+	kafkaflags "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/httputil"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/service"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
 )
 
 // New creates a new command factory
@@ -24,7 +31,9 @@ func New(cliVersion string, localizer localize.Localizer) *Factory {
 
 	var logger logging.Logger
 	var conn connection.Connection
-	cfgFile := config.NewFile()
+	// the connection uses the user configuration directly,
+	// so that it can be used to resolve the values of the project context
+	userCfgFile := config.NewFile()
 
 	loggerFunc := func() (logging.Logger, error) {
 		if logger != nil {
@@ -51,7 +60,7 @@ func New(cliVersion string, localizer localize.Localizer) *Factory {
 			return conn, nil
 		}
 
-		cfg, err := cfgFile.Load()
+		cfg, err := userCfgFile.Load()
 		if err != nil {
 			return nil, err
 		}
@@ -91,7 +100,7 @@ func New(cliVersion string, localizer localize.Localizer) *Factory {
 
 		builder.WithInsecure(cfg.Insecure)
 
-		builder.WithConfig(cfgFile)
+		builder.WithConfig(userCfgFile)
 
 		// create a logger if it has not already been created
 		logger, err = loggerFunc()
@@ -123,11 +132,93 @@ func New(cliVersion string, localizer localize.Localizer) *Factory {
 		return conn, nil
 	}
 
+	// Kafka instances selected by name are resolved once, and then read from the cache
+	resolveKafka := func(nameOrID string) (string, error) {
+		cfg, err := userCfgFile.Load()
+		if err != nil {
+			return "", err
+		}
+
+		var idCache *kafka.IDCache
+		if !cache.Disabled() {
+			idCache, _ = kafka.DefaultIDCache()
+		}
+		if idCache != nil {
+			if id, ok := idCache.Get(cfg.APIUrl, nameOrID); ok {
+				return id, nil
+			}
+		}
+
+		conn, err := connectionFunc(connection.DefaultConfigSkipMasAuth)
+		if err != nil {
+			return "", err
+		}
+
+		kafkaInstance, _, err := kafka.GetKafkaByNameOrID(context.Background(), conn.API().Kafka(), nameOrID)
+		if err != nil {
+			return "", err
+		}
+
+		if idCache != nil {
+			_ = idCache.Set(cfg.APIUrl, nameOrID, kafkaInstance.GetId())
+		}
+
+		return kafkaInstance.GetId(), nil
+	}
+
+	// cacheFunc returns the cache of API responses of the logged in account, or nil when caching is disabled
+	cacheFunc := func() *cache.Cache {
+		if cache.Disabled() {
+			return nil
+		}
+
+		cfg, err := userCfgFile.Load()
+		if err != nil {
+			return nil
+		}
+
+		// responses are cached per account, as they depend on its permissions
+		username, ok := token.GetUsername(cfg.AccessToken)
+		if !ok {
+			return nil
+		}
+
+		cacheDir, err := cache.DefaultDir()
+		if err != nil {
+			return nil
+		}
+
+		return cache.New(cacheDir, cfg.APIUrl, username)
+	}
+
+	wd, _ := os.Getwd()
+	// the Kafka instance selected with the --kafka flag and the project context file
+	// take precedence over the user configuration
+	cfgFile := config.NewLocalOverride(userCfgFile, config.OverrideOptions{
+		Dir:           wd,
+		KafkaInstance: kafkaflags.Instance,
+		ResolveKafka:  resolveKafka,
+		// the commands go on without a Kafka instance, and explain how to select one
+		KafkaNotResolved: func(name string, path string, err error) {
+			logger, loggerErr := loggerFunc()
+			if loggerErr != nil {
+				return
+			}
+			logger.Info(localizer.MustLocalize("context.log.info.kafkaNotResolved",
+				localize.NewEntry("Name", name),
+				localize.NewEntry("Path", path),
+				localize.NewEntry("ErrorMessage", err),
+			))
+		},
+	})
+
 	return &Factory{
 		IOStreams:  io,
 		Config:     cfgFile,
 		Connection: connectionFunc,
 		Logger:     loggerFunc,
 		Localizer:  localizer,
+		Services:   service.NewRegistry(kafka.ServiceProvider{}, serviceregistry.ServiceProvider{}),
+		Cache:      cacheFunc,
 	}
 }
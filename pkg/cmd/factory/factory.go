@@ -2,10 +2,12 @@ package factory
 
 import (
 	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/service"
 )
 
 // Factory is an abstract type which provides access to
@@ -21,6 +23,11 @@ type Factory struct {
 	Logger func() (logging.Logger, error)
 	// Localizer provides text to the commands
 	Localizer localize.Localizer
+	// Providers of the application services supported by the CLI
+	Services *service.Registry
+	// Returns the cache of control plane responses for the current account,
+	// which is nil when the cache is disabled or the user is not logged in
+	Cache func() *cache.Cache
 }
 
 type ConnectionFunc func(cfg *connection.Config) (connection.Connection, error)
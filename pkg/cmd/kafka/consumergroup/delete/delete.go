@@ -6,18 +6,23 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	"github.com/spf13/cobra"
 )
 
 type Options struct {
 	kafkaID     string
 	id          string
+	ids         []string
+	pattern     string
+	regex       string
 	skipConfirm bool
 
 	IO         *iostreams.IOStreams
@@ -27,7 +32,7 @@ type Options struct {
 	localizer  localize.Localizer
 }
 
-// NewDeleteConsumerGroupCommand gets a new command for deleting a consumer group.
+// NewDeleteConsumerGroupCommand gets a new command for deleting consumer groups.
 func NewDeleteConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 	opts := &Options{
 		Connection: f.Connection,
@@ -42,8 +47,28 @@ func NewDeleteConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 		Short:   opts.localizer.MustLocalize("kafka.consumerGroup.delete.cmd.shortDescription"),
 		Long:    opts.localizer.MustLocalize("kafka.consumerGroup.delete.cmd.longDescription"),
 		Example: opts.localizer.MustLocalize("kafka.consumerGroup.delete.cmd.example"),
-		Args:    cobra.NoArgs,
+		Args:    cobra.ArbitraryArgs,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidConsumerGroupIDs(f, toComplete)
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if !opts.IO.CanPrompt() && !opts.skipConfirm {
+				return errors.New(opts.localizer.MustLocalize("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes")))
+			}
+
+			if opts.id != "" {
+				args = append([]string{opts.id}, args...)
+			}
+
+			if len(args) == 0 && opts.pattern == "" && opts.regex == "" {
+				return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.delete.error.noConsumerGroupsSpecified"))
+			}
+
+			opts.ids, err = bulk.CollectArgs(args, opts.IO.In)
+			if err != nil {
+				return err
+			}
+
 			if opts.kafkaID != "" {
 				return runCmd(opts)
 			}
@@ -63,10 +88,10 @@ func NewDeleteConsumerGroupCommand(f *factory.Factory) *cobra.Command {
 		},
 	}
 
-	opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "delete"))
 	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.yes.description"))
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("kafka.consumerGroup.common.flag.id.description", localize.NewEntry("Action", "delete")))
-	_ = cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.pattern.description"))
+	cmd.Flags().StringVar(&opts.regex, "regex", "", opts.localizer.MustLocalize("kafka.consumerGroup.delete.flag.regex.description"))
 
 	// flag based completions for ID
 	_ = cmd.RegisterFlagCompletionFunc("id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -94,12 +119,81 @@ func runCmd(opts *Options) error {
 		return err
 	}
 
-	ctx := context.Background()
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
 
-	_, httpRes, err := api.GetConsumerGroupById(ctx, opts.id).Execute()
+	matches, err := bulk.NewMatcher(opts.localizer, opts.pattern, opts.regex)
+	if err != nil {
+		return err
+	}
 
-	cgIDPair := localize.NewEntry("ID", opts.id)
-	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+	if matches != nil {
+		cgList, _, err := api.GetConsumerGroups(context.Background()).Execute()
+		if err != nil {
+			return err
+		}
+
+		for _, cg := range cgList.GetItems() {
+			if matches(cg.GetGroupId()) {
+				opts.ids = append(opts.ids, cg.GetGroupId())
+			}
+		}
+	}
+
+	ids := bulk.Unique(opts.ids)
+	if len(ids) == 0 {
+		logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.noConsumerGroupsMatched", kafkaNameTmplPair))
+		return nil
+	}
+
+	// a single consumer group keeps the original flow of checking
+	// that the consumer group exists and confirming its ID
+	if len(ids) == 1 {
+		return deleteSingleConsumerGroup(opts, api, kafkaInstance.GetName(), ids[0])
+	}
+
+	if !opts.skipConfirm {
+		logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.consumerGroupsToDelete", kafkaNameTmplPair))
+		for _, id := range ids {
+			logger.Info("  " + id)
+		}
+
+		var confirmDelete bool
+		promptConfirmDelete := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.consumerGroup.delete.input.confirmDeleteMultiple.message", localize.NewEntry("Count", len(ids))),
+		}
+		if err = survey.AskOne(promptConfirmDelete, &confirmDelete); err != nil {
+			return err
+		}
+
+		if !confirmDelete {
+			logger.Debug(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.debug.deleteNotConfirmed"))
+			return nil
+		}
+	}
+
+	results := bulk.Run(ids, bulk.DefaultConcurrency, func(id string) error {
+		return deleteConsumerGroup(opts, api, kafkaInstance.GetName(), id)
+	})
+
+	bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.deleted"))
+
+	if failed := bulk.Failed(results); failed > 0 {
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.delete.error.someFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results))))
+	}
+
+	return nil
+}
+
+func deleteSingleConsumerGroup(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string, id string) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	_, httpRes, err := api.GetConsumerGroupById(context.Background(), id).Execute()
+
+	cgIDPair := localize.NewEntry("ID", id)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaName)
 	if err != nil {
 		if httpRes == nil {
 			return err
@@ -120,36 +214,46 @@ func runCmd(opts *Options) error {
 			return err
 		}
 
-		if confirmedID != opts.id {
+		if confirmedID != id {
 			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.delete.error.mismatchedIDConfirmation", localize.NewEntry("ConfirmedID", confirmedID), cgIDPair))
 		}
 	}
 
-	httpRes, err = api.DeleteConsumerGroupById(ctx, opts.id).Execute()
+	if err = deleteConsumerGroup(opts, api, kafkaName, id); err != nil {
+		return err
+	}
 
-	if err != nil {
-		if httpRes == nil {
-			return err
-		}
+	logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.consumerGroupDeleted", localize.NewEntry("ConsumerGroupID", id), kafkaNameTmplPair))
 
-		operationTmplPair := localize.NewEntry("Operation", "delete")
-		switch httpRes.StatusCode {
-		case 401:
-			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.unauthorized", operationTmplPair))
-		case 403:
-			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.forbidden", operationTmplPair))
-		case 423:
-			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.delete.error.locked"))
-		case 500:
-			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.internalServerError"))
-		case 503:
-			return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName())))
-		default:
-			return err
-		}
+	return nil
+}
+
+// perform delete consumer group API request
+func deleteConsumerGroup(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string, id string) error {
+	httpRes, err := api.DeleteConsumerGroupById(context.Background(), id).Execute()
+	if err == nil {
+		return nil
 	}
 
-	logger.Info(opts.localizer.MustLocalize("kafka.consumerGroup.delete.log.info.consumerGroupDeleted", localize.NewEntry("ConsumerGroupID", opts.id), kafkaNameTmplPair))
+	if httpRes == nil {
+		return err
+	}
 
-	return nil
+	operationTmplPair := localize.NewEntry("Operation", "delete")
+	switch httpRes.StatusCode {
+	case 401:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.unauthorized", operationTmplPair))
+	case 403:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.forbidden", operationTmplPair))
+	case 404:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.notFoundError", localize.NewEntry("ID", id), localize.NewEntry("InstanceName", kafkaName)))
+	case 423:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.delete.error.locked"))
+	case 500:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.internalServerError"))
+	case 503:
+		return errors.New(opts.localizer.MustLocalize("kafka.consumerGroup.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaName)))
+	default:
+		return err
+	}
 }
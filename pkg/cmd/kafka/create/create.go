@@ -9,6 +9,7 @@ import (
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 
 	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
@@ -45,6 +46,7 @@ type Options struct {
 
 	outputFormat string
 	autoUse      bool
+	dryRun       bool
 
 	interactive bool
 
@@ -53,6 +55,7 @@ type Options struct {
 	Connection factory.ConnectionFunc
 	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
+	Cache      func() *cache.Cache
 }
 
 const (
@@ -70,6 +73,7 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 		Connection: f.Connection,
 		Logger:     f.Logger,
 		localizer:  f.Localizer,
+		Cache:      f.Cache,
 
 		multiAZ: defaultMultiAZ,
 	}
@@ -111,11 +115,20 @@ func NewCreateCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.region, flags.FlagRegion, "", opts.localizer.MustLocalize("kafka.create.flag.cloudRegion.description"))
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("kafka.common.flag.output.description"))
 	cmd.Flags().BoolVar(&opts.autoUse, "use", true, opts.localizer.MustLocalize("kafka.create.flag.autoUse.description"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.create.flag.dryRun.description"))
 
 	_ = cmd.RegisterFlagCompletionFunc(flags.FlagProvider, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return cmdutil.FetchCloudProviders(f)
 	})
 
+	_ = cmd.RegisterFlagCompletionFunc(flags.FlagRegion, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		provider, _ := cmd.Flags().GetString(flags.FlagProvider)
+		if provider == "" {
+			provider = defaultProvider
+		}
+		return cmdutil.FetchCloudRegions(f, provider)
+	})
+
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
@@ -140,19 +153,29 @@ func runCreate(opts *Options) error {
 
 	api := connection.API()
 
-	// the user must have accepted the terms and conditions from the provider
-	// before they can create a kafka instance
-	termsAccepted, termsURL, err := checkTermsAccepted(opts.Connection)
-	if err != nil {
-		return err
-	}
-	if !termsAccepted && termsURL != "" {
-		logger.Info(opts.localizer.MustLocalize("kafka.create.log.info.termsCheck", localize.NewEntry("TermsURL", termsURL)))
-		return nil
-	}
-
 	var payload *kafkamgmtclient.KafkaRequestPayload
+	var checks []func() error
 	if opts.interactive {
+		// the user must have accepted the terms and conditions from the provider
+		// before they can create a kafka instance
+		termsAccepted, termsURL, err := checkTermsAccepted(opts.Connection)
+		if err != nil {
+			return err
+		}
+		if !termsAccepted && termsURL != "" {
+			logger.Info(opts.localizer.MustLocalize("kafka.create.log.info.termsCheck", localize.NewEntry("TermsURL", termsURL)))
+			return nil
+		}
+
+		// the organization is checked before prompting, so that the user does not answer the prompts in vain
+		orgChecks, err := accountChecks(opts, api)
+		if err != nil {
+			return err
+		}
+		if err = runPreflightChecks(opts, orgChecks); err != nil {
+			return err
+		}
+
 		logger.Debug()
 
 		payload, err = promptKafkaPayload(opts)
@@ -174,6 +197,24 @@ func runCreate(opts *Options) error {
 			CloudProvider: &opts.provider,
 			MultiAz:       &opts.multiAZ,
 		}
+
+		if checks, err = accountChecks(opts, api); err != nil {
+			return err
+		}
+	}
+
+	checks = append(checks, instanceChecks(opts, api, payload)...)
+	if err = runPreflightChecks(opts, checks); err != nil {
+		return err
+	}
+
+	if opts.dryRun {
+		logger.Info(opts.localizer.MustLocalize("kafka.create.log.info.dryRun",
+			localize.NewEntry("Name", payload.Name),
+			localize.NewEntry("Provider", payload.GetCloudProvider()),
+			localize.NewEntry("Region", payload.GetRegion()),
+		))
+		return nil
 	}
 
 	logger.Info(opts.localizer.MustLocalize("kafka.create.log.info.creatingKafka", localize.NewEntry("Name", payload.Name)))
@@ -193,6 +234,10 @@ func runCreate(opts *Options) error {
 
 	logger.Info(opts.localizer.MustLocalize("kafka.create.info.successMessage", localize.NewEntry("Name", response.GetName())))
 
+	if opts.Cache != nil {
+		_ = opts.Cache().Remove(cache.InstancesKey(pkgKafka.ServiceName))
+	}
+
 	switch opts.outputFormat {
 	case "json":
 		data, _ := json.MarshalIndent(response, "", cmdutil.DefaultJSONIndent)
@@ -202,13 +247,9 @@ func runCreate(opts *Options) error {
 		_ = dump.YAML(opts.IO.Out, data)
 	}
 
-	kafkaCfg := &config.KafkaConfig{
-		ClusterID: response.GetId(),
-	}
-
 	if opts.autoUse {
 		logger.Debug(opts.localizer.MustLocalize("kafka.create.debug.autoUseSetMessage"))
-		cfg.Services.Kafka = kafkaCfg
+		kafka.ServiceProvider{}.Use(cfg, response.GetId())
 		if err := opts.Config.Save(cfg); err != nil {
 			return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("kafka.common.error.couldNotUseKafka"), err)
 		}
@@ -235,7 +276,7 @@ func promptKafkaPayload(opts *Options) (payload *kafkamgmtclient.KafkaRequestPay
 		MultiAZ       bool
 		CloudProvider string
 	}{
-		MultiAZ: defaultMultiAZ,
+		MultiAZ: opts.multiAZ,
 	}
 
 	promptName := &survey.Input{
@@ -248,13 +289,18 @@ func promptKafkaPayload(opts *Options) (payload *kafkamgmtclient.KafkaRequestPay
 		return nil, err
 	}
 
+	// cloud providers and regions rarely change, so they are read from the cache when possible
+	var responseCache *cache.Cache
+	if opts.Cache != nil {
+		responseCache = opts.Cache()
+	}
+
 	// fetch all cloud available providers
-	cloudProviderResponse, _, err := api.Kafka().GetCloudProviders(context.Background()).Execute()
+	cloudProviders, err := cloudproviderutil.List(context.Background(), api.Kafka(), responseCache)
 	if err != nil {
 		return nil, err
 	}
 
-	cloudProviders := cloudProviderResponse.GetItems()
 	cloudProviderNames := cloudproviderutil.GetEnabledNames(cloudProviders)
 
 	cloudProviderPrompt := &survey.Select{
@@ -270,13 +316,11 @@ func promptKafkaPayload(opts *Options) (payload *kafkamgmtclient.KafkaRequestPay
 	// get the selected provider type from the name selected
 	selectedCloudProvider := cloudproviderutil.FindByName(cloudProviders, answers.CloudProvider)
 
-	// nolint
-	cloudRegionResponse, _, err := api.Kafka().GetCloudProviderRegions(context.Background(), selectedCloudProvider.GetId()).Execute()
+	regions, err := cloudregionutil.List(context.Background(), api.Kafka(), responseCache, selectedCloudProvider.GetId())
 	if err != nil {
 		return nil, err
 	}
 
-	regions := cloudRegionResponse.GetItems()
 	regionIDs := cloudregionutil.GetEnabledIDs(regions)
 
 	regionPrompt := &survey.Select{
@@ -0,0 +1,218 @@
+package create
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudprovider/cloudproviderutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudregion/cloudregionutil"
+	pkgKafka "github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// runPreflightChecks runs every check before the Kafka instance is requested,
+// logging an explanation of each check that fails
+func runPreflightChecks(opts *Options, checks []func() error) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	for _, check := range checks {
+		if err = check(); err != nil {
+			logger.Error(err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New(opts.localizer.MustLocalize("kafka.create.error.preflightFailed"))
+	}
+
+	return nil
+}
+
+// accountChecks returns the checks that the organization of the user can create a Kafka instance.
+// They do not depend on the instance, so in interactive mode they are run before the user is prompted.
+func accountChecks(opts *Options, api *api.API) ([]func() error, error) {
+	logger, err := opts.Logger()
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := accountmgmtutil.GetOrganizationID(context.Background(), api.AccountMgmt())
+	if err != nil {
+		// the permission and quota are only checked on a best-effort basis, as the service enforces them anyway
+		logger.Debug(opts.localizer.MustLocalize("kafka.create.log.debug.permissionCheckSkipped", localize.NewEntry("Error", err)))
+		logger.Debug(opts.localizer.MustLocalize("kafka.create.log.debug.quotaCheckSkipped", localize.NewEntry("Error", err)))
+		return nil, nil
+	}
+
+	return []func() error{
+		func() error { return checkPermission(opts, api, orgID) },
+		func() error { return checkQuota(opts, api, orgID) },
+	}, nil
+}
+
+// instanceChecks returns the checks that the Kafka instance described by payload can be created
+func instanceChecks(opts *Options, api *api.API, payload *kafkamgmtclient.KafkaRequestPayload) []func() error {
+	checks := []func() error{
+		func() error { return checkCloudRegion(opts, api, payload) },
+	}
+	// the interactive prompt already checked the terms and validated the name
+	if !opts.interactive {
+		checks = append([]func() error{
+			func() error { return checkTerms(opts) },
+			func() error { return pkgKafka.ValidateNameIsAvailable(api.Kafka(), opts.localizer)(payload.Name) },
+		}, checks...)
+	}
+
+	return checks
+}
+
+// checkTerms checks that the user has accepted the terms and conditions
+func checkTerms(opts *Options) error {
+	termsAccepted, termsURL, err := checkTermsAccepted(opts.Connection)
+	if err != nil {
+		return err
+	}
+	if !termsAccepted && termsURL != "" {
+		return errors.New(opts.localizer.MustLocalize("kafka.create.log.info.termsCheck", localize.NewEntry("TermsURL", termsURL)))
+	}
+
+	return nil
+}
+
+// checkPermission checks that the user is allowed to create Kafka instances in their organization
+func checkPermission(opts *Options, api *api.API, orgID string) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	allowed, err := accountmgmtutil.ReviewAccess(context.Background(), api.AccountMgmt(), accountmgmtutil.AccessReview{
+		Action:         "create",
+		ResourceType:   accountmgmtutil.ResourceTypeCluster,
+		OrganizationID: orgID,
+	})
+	if err == nil {
+		if !allowed {
+			return errors.New(opts.localizer.MustLocalize("kafka.create.error.notAllowed"))
+		}
+		return nil
+	}
+
+	// the permission is only checked on a best-effort basis, as the service enforces it anyway
+	logger.Debug(opts.localizer.MustLocalize("kafka.create.log.debug.permissionCheckSkipped", localize.NewEntry("Error", err)))
+
+	return nil
+}
+
+// checkCloudRegion checks that the cloud provider and region exist and are enabled
+func checkCloudRegion(opts *Options, api *api.API, payload *kafkamgmtclient.KafkaRequestPayload) error {
+	providerID := payload.GetCloudProvider()
+	providerEntry := localize.NewEntry("Provider", providerID)
+
+	// the cached providers and regions may be outdated, so they are fetched again
+	// and the cache is refreshed with the current ones
+	var responseCache *cache.Cache
+	if opts.Cache != nil {
+		responseCache = opts.Cache()
+		_ = responseCache.Remove(cache.CloudProvidersKey)
+		_ = responseCache.Remove(cache.CloudRegionsKey(providerID))
+	}
+
+	ctx := context.Background()
+	cloudProviders, err := cloudproviderutil.List(ctx, api.Kafka(), responseCache)
+	if err != nil {
+		return err
+	}
+
+	provider := cloudproviderutil.FindByID(cloudProviders, providerID)
+	if provider == nil {
+		return errors.New(opts.localizer.MustLocalize("kafka.create.error.providerNotSupported", providerEntry))
+	}
+	if !provider.GetEnabled() {
+		return errors.New(opts.localizer.MustLocalize("kafka.create.error.providerNotEnabled", providerEntry))
+	}
+
+	regions, err := cloudregionutil.List(ctx, api.Kafka(), responseCache, providerID)
+	if err != nil {
+		return err
+	}
+
+	regionEntry := localize.NewEntry("Region", payload.GetRegion())
+	for _, r := range regions {
+		if r.GetId() != payload.GetRegion() {
+			continue
+		}
+		if !r.GetEnabled() {
+			return errors.New(opts.localizer.MustLocalize("kafka.create.error.regionNotEnabled", providerEntry, regionEntry))
+		}
+		return nil
+	}
+
+	return errors.New(opts.localizer.MustLocalize("kafka.create.error.regionNotSupported", providerEntry, regionEntry))
+}
+
+// checkQuota checks that the organization has quota left for a standard Kafka instance.
+// Organizations without standard quota can create trial instances, so they are not checked.
+func checkQuota(opts *Options, api *api.API, orgID string) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	quotas, err := accountmgmtutil.GetKafkaQuotas(context.Background(), api.AccountMgmt(), orgID)
+	if err == nil {
+		return checkStandardQuota(opts, accountmgmtutil.FilterByProduct(quotas, accountmgmtutil.ProductStandard))
+	}
+
+	// the quota is only checked on a best-effort basis, as the service enforces it anyway
+	logger.Debug(opts.localizer.MustLocalize("kafka.create.log.debug.quotaCheckSkipped", localize.NewEntry("Error", err)))
+
+	return nil
+}
+
+// checkStandardQuota checks that the standard quota of the organization allows an instance
+// in the availability zones of the instance, and that some of it is left
+func checkStandardQuota(opts *Options, quotas []accountmgmtutil.Quota) error {
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	zoned := accountmgmtutil.FilterByAvailabilityZone(quotas, opts.multiAZ)
+	if len(zoned) == 0 {
+		if opts.multiAZ {
+			return errors.New(opts.localizer.MustLocalize("kafka.create.error.multiAZNotAllowed"))
+		}
+		return errors.New(opts.localizer.MustLocalize("kafka.create.error.singleAZNotAllowed"))
+	}
+
+	return checkRemainingQuota(opts, zoned)
+}
+
+func checkRemainingQuota(opts *Options, quotas []accountmgmtutil.Quota) error {
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	var allowed, consumed int
+	for _, q := range quotas {
+		if q.Remaining() >= q.Cost {
+			return nil
+		}
+		allowed += q.Instances(q.Allowed)
+		consumed += q.Instances(q.Consumed)
+	}
+
+	return errors.New(opts.localizer.MustLocalize("kafka.create.error.quotaExceeded",
+		localize.NewEntry("Allowed", allowed),
+		localize.NewEntry("Consumed", consumed),
+	))
+}
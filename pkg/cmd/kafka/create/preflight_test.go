@@ -0,0 +1,39 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/localize/goi18n"
+)
+
+func TestCheckStandardQuota(t *testing.T) {
+	localizer, err := goi18n.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	multi := accountmgmtutil.Quota{Product: accountmgmtutil.ProductStandard, Allowed: 2, Consumed: 1, Cost: 1, AvailabilityZoneType: accountmgmtutil.AvailabilityZoneMulti}
+	used := accountmgmtutil.Quota{Product: accountmgmtutil.ProductStandard, Allowed: 1, Consumed: 1, Cost: 1, AvailabilityZoneType: accountmgmtutil.AvailabilityZoneAny}
+
+	tests := []struct {
+		name    string
+		multiAZ bool
+		quotas  []accountmgmtutil.Quota
+		wantErr bool
+	}{
+		{name: "organizations without standard quota are not checked", multiAZ: true},
+		{name: "quota is left in multiple availability zones", multiAZ: true, quotas: []accountmgmtutil.Quota{multi}},
+		{name: "no quota for a single availability zone", multiAZ: false, quotas: []accountmgmtutil.Quota{multi}, wantErr: true},
+		{name: "no quota is left", multiAZ: false, quotas: []accountmgmtutil.Quota{multi, used}, wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &Options{multiAZ: tt.multiAZ, localizer: localizer}
+			if err := checkStandardQuota(opts, tt.quotas); (err != nil) != tt.wantErr {
+				t.Errorf("checkStandardQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
@@ -32,6 +33,7 @@ type options struct {
 	Connection factory.ConnectionFunc
 	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
+	Cache      func() *cache.Cache
 }
 
 // NewDeleteCommand command for deleting kafkas.
@@ -42,6 +44,7 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Logger:     f.Logger,
 		IO:         f.IOStreams,
 		localizer:  f.Localizer,
+		Cache:      f.Cache,
 	}
 
 	cmd := &cobra.Command{
@@ -75,8 +78,7 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
-			var kafkaConfig *config.KafkaConfig
-			if cfg.Services.Kafka == kafkaConfig || cfg.Services.Kafka.ClusterID == "" {
+			if !cfg.HasKafka() {
 				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
 			}
 
@@ -155,15 +157,18 @@ func runDelete(opts *options) error {
 
 	logger.Info(opts.localizer.MustLocalize("kafka.delete.log.info.deleteSuccess", localize.NewEntry("Name", kafkaName)))
 
-	currentKafka := cfg.Services.Kafka
+	if opts.Cache != nil {
+		_ = opts.Cache().Remove(cache.InstancesKey(kafka.ServiceName))
+	}
+
 	// this is not the current cluster, our work here is done
-	if currentKafka == nil || currentKafka.ClusterID != response.GetId() {
+	if !cfg.HasKafka() || cfg.Services.Kafka.ClusterID != response.GetId() {
 		return nil
 	}
 
 	// the Kafka that was deleted is set as the user's current cluster
 	// since it was deleted it should be removed from the config
-	cfg.Services.Kafka = nil
+	kafka.ServiceProvider{}.Unuse(cfg)
 	err = opts.Config.Save(cfg)
 	if err != nil {
 		return err
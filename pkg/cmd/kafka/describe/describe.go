@@ -1,14 +1,18 @@
 package describe
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
 	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
 
@@ -30,6 +34,7 @@ type Options struct {
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
 }
 
@@ -39,6 +44,7 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	opts := &Options{
 		Config:     f.Config,
 		Connection: f.Connection,
+		Logger:     f.Logger,
 		IO:         f.IOStreams,
 		localizer:  f.Localizer,
 	}
@@ -76,8 +82,7 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
-			var kafkaConfig *config.KafkaConfig
-			if cfg.Services.Kafka == kafkaConfig || cfg.Services.Kafka.ClusterID == "" {
+			if !cfg.HasKafka() {
 				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
 			}
 
@@ -117,19 +122,73 @@ func runDescribe(opts *Options) error {
 		}
 	}
 
-	return printKafka(kafkaInstance, opts)
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	// the labels are only shown when they can be read, as the instance is described without them otherwise
+	labels, err := getLabels(api.AccountMgmt(), kafkaInstance.GetId())
+	if err != nil {
+		logger.Debug(opts.localizer.MustLocalize("kafka.describe.log.debug.labelsNotFound", localize.NewEntry("Error", err)))
+	}
+
+	return printKafka(kafkaInstance, labels, opts)
 }
 
-func printKafka(kafka *kafkamgmtclient.KafkaRequest, opts *Options) error {
+func getLabels(api amsclient.DefaultApi, kafkaID string) (map[string]string, error) {
+	ctx := context.Background()
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api, kafkaID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountmgmtutil.GetLabels(ctx, api, subscription.GetId())
+}
+
+// kafkaWithLabels is a Kafka instance with the labels of its subscription
+type kafkaWithLabels struct {
+	kafkamgmtclient.KafkaRequest `yaml:",inline"`
+	Labels                       map[string]string `yaml:"labels"`
+}
+
+// MarshalJSON marshals the instance as it is marshalled without labels, with the labels added as its last field
+func (k kafkaWithLabels) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(k.KafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := json.Marshal(k.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := bytes.TrimSuffix(bytes.TrimSpace(data), []byte("}"))
+	if len(bytes.TrimSpace(fields)) > 1 {
+		fields = append(fields, ',')
+	}
+	fields = append(fields, []byte(`"labels":`)...)
+	fields = append(fields, labels...)
+
+	return append(fields, '}'), nil
+}
+
+func printKafka(kafka *kafkamgmtclient.KafkaRequest, labels map[string]string, opts *Options) error {
+	var v interface{} = kafka
+	if labels != nil {
+		v = kafkaWithLabels{KafkaRequest: *kafka, Labels: labels}
+	}
+
 	switch opts.outputFormat {
 	case "yaml", "yml":
-		data, err := yaml.Marshal(kafka)
+		data, err := yaml.Marshal(v)
 		if err != nil {
 			return err
 		}
 		return dump.YAML(opts.IO.Out, data)
 	default:
-		data, err := json.Marshal(kafka)
+		data, err := json.Marshal(v)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,52 @@
+package describe
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"gopkg.in/yaml.v2"
+)
+
+func TestKafkaWithLabels(t *testing.T) {
+	id, name := "c2qe1hg7a5mo8sj6bc0g", "my-kafka"
+	kafka := kafkamgmtclient.KafkaRequest{Id: &id, Name: &name}
+
+	want, err := json.Marshal(kafka)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(kafkaWithLabels{KafkaRequest: kafka, Labels: map[string]string{"env": "prod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the fields of the instance are unchanged, and the labels are added after them
+	wantJSON := strings.TrimSuffix(string(want), "}") + `,"labels":{"env":"prod"}}`
+	if string(data) != wantJSON {
+		t.Errorf("json.Marshal() = %s, want %s", data, wantJSON)
+	}
+
+	data, err = yaml.Marshal(kafkaWithLabels{KafkaRequest: kafka, Labels: map[string]string{"env": "prod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err = yaml.Marshal(kafka)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wantYAML := string(want) + "labels:\n  env: prod\n"; string(data) != wantYAML {
+		t.Errorf("yaml.Marshal() = %s, want %s", data, wantYAML)
+	}
+
+	// an instance without fields only has the labels
+	data, err = json.Marshal(kafkaWithLabels{Labels: map[string]string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"labels":{}}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+}
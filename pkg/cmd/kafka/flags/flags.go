@@ -15,4 +15,6 @@ const (
 	FlagMultiAZ = "multi-az"
 	// FlagClusterID is a flag representing a Kafka's cluster ID
 	FlagClusterID = "cluster-id"
+	// FlagKafka is a global flag representing the name or ID of the Kafka instance to use
+	FlagKafka = "kafka"
 )
@@ -0,0 +1,23 @@
+// This file contains functions used to implement the global '--kafka' command line option.
+
+package flags
+
+import "github.com/spf13/pflag"
+
+// AddInstanceFlag adds the '--kafka' flag to the given set of command line flags
+func AddInstanceFlag(fs *pflag.FlagSet, description string) {
+	fs.StringVar(
+		&instance,
+		FlagKafka,
+		"",
+		description,
+	)
+}
+
+// Instance returns the name or ID of the Kafka instance selected with the '--kafka' flag
+func Instance() string {
+	return instance
+}
+
+// instance is the name or ID of the Kafka instance which the commands are run against
+var instance string
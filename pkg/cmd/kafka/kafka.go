@@ -11,7 +11,12 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/describe"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/label"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/metrics"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/providers"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/regions"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/unuse"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/use"
 )
 
@@ -29,8 +34,13 @@ func NewKafkaCommand(f *factory.Factory) *cobra.Command {
 		delete.NewDeleteCommand(f),
 		list.NewListCommand(f),
 		use.NewUseCommand(f),
+		unuse.NewUnuseCommand(f),
 		topic.NewTopicCommand(f),
 		consumergroup.NewConsumerGroupCommand(f),
+		providers.NewProvidersCommand(f),
+		regions.NewRegionsCommand(f),
+		label.NewLabelCommand(f),
+		metrics.NewMetricsCommand(f),
 	)
 
 	return cmd
@@ -0,0 +1,132 @@
+package add
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+// label is a label given on the command line
+type label struct {
+	key   string
+	value string
+}
+
+type Options struct {
+	kafkaID   string
+	labels    []label
+	overwrite bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewAddCommand creates a command to add labels to a Kafka instance
+func NewAddCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.label.add.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.label.add.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.label.add.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.label.add.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				key, value, ok := accountmgmtutil.ParseLabel(arg)
+				if !ok {
+					return errors.New(opts.localizer.MustLocalize("kafka.label.common.error.invalidLabel", localize.NewEntry("Label", arg)))
+				}
+				opts.labels = append(opts.labels, label{key: key, value: value})
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runAdd(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, opts.localizer.MustLocalize("kafka.label.add.flag.overwrite.description"))
+
+	return cmd
+}
+
+func runAdd(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api, opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := accountmgmtutil.GetLabels(ctx, api, subscription.GetId())
+	if err != nil {
+		return err
+	}
+
+	// all labels are checked before any is added, so that a conflict does not leave some of them added
+	for _, l := range opts.labels {
+		if value, ok := existing[l.key]; ok && value != l.value && !opts.overwrite {
+			return errors.New(opts.localizer.MustLocalize("kafka.label.add.error.labelExists",
+				localize.NewEntry("Key", l.key),
+				localize.NewEntry("Value", value),
+			))
+		}
+	}
+
+	for _, l := range opts.labels {
+		value, exists := existing[l.key]
+		if exists && value == l.value {
+			continue
+		}
+
+		if err = accountmgmtutil.SetLabel(ctx, api, subscription.GetId(), l.key, l.value, exists); err != nil {
+			return err
+		}
+
+		logger.Info(opts.localizer.MustLocalize("kafka.label.add.log.info.labelAdded",
+			localize.NewEntry("Key", l.key),
+			localize.NewEntry("Value", l.value),
+			localize.NewEntry("ID", opts.kafkaID),
+		))
+	}
+
+	return nil
+}
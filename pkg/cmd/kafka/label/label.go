@@ -0,0 +1,27 @@
+// Package label contains commands for managing the labels of Kafka instances
+package label
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/label/add"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/label/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/label/remove"
+	"github.com/spf13/cobra"
+)
+
+func NewLabelCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("kafka.label.cmd.use"),
+		Short: f.Localizer.MustLocalize("kafka.label.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("kafka.label.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		add.NewAddCommand(f),
+		remove.NewRemoveCommand(f),
+		list.NewListCommand(f),
+	)
+
+	return cmd
+}
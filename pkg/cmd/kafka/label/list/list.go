@@ -0,0 +1,129 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// labelRow is a label printed to a table
+type labelRow struct {
+	Key   string `header:"Key"`
+	Value string `header:"Value"`
+}
+
+type Options struct {
+	kafkaID      string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a command to list the labels of a Kafka instance
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.label.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.label.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.label.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.label.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("kafka.label.list.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api, opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	labels, err := accountmgmtutil.GetLabels(ctx, api, subscription.GetId())
+	if err != nil {
+		return err
+	}
+
+	if len(labels) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("kafka.label.list.log.info.noLabels"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(labels)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(labels)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		rows := []labelRow{}
+		for key, value := range labels {
+			rows = append(rows, labelRow{Key: key, Value: value})
+		}
+		sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+		dump.Table(opts.IO.Out, rows)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package remove
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	kafkaID string
+	keys    []string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewRemoveCommand creates a command to remove labels from a Kafka instance
+func NewRemoveCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.label.remove.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.label.remove.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.label.remove.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.label.remove.cmd.example"),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.keys = args
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runRemove(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runRemove(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api, opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := accountmgmtutil.GetLabels(ctx, api, subscription.GetId())
+	if err != nil {
+		return err
+	}
+
+	for _, key := range opts.keys {
+		keyTmplEntry := localize.NewEntry("Key", key)
+		if _, ok := existing[key]; !ok {
+			logger.Info(opts.localizer.MustLocalize("kafka.label.remove.log.info.labelNotFound", keyTmplEntry))
+			continue
+		}
+
+		if err = accountmgmtutil.DeleteLabel(ctx, api, subscription.GetId(), key); err != nil {
+			return err
+		}
+
+		logger.Info(opts.localizer.MustLocalize("kafka.label.remove.log.info.labelRemoved", keyTmplEntry, localize.NewEntry("ID", opts.kafkaID)))
+	}
+
+	return nil
+}
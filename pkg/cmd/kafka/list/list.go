@@ -3,9 +3,12 @@ package list
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
 	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
@@ -40,6 +43,7 @@ type options struct {
 	page         int
 	limit        int
 	search       string
+	labels       map[string]string
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
@@ -54,6 +58,7 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 		page:       0,
 		limit:      100,
 		search:     "",
+		labels:     map[string]string{},
 		Config:     f.Config,
 		Connection: f.Connection,
 		Logger:     f.Logger,
@@ -61,6 +66,8 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 		localizer:  f.Localizer,
 	}
 
+	var labels []string
+
 	cmd := &cobra.Command{
 		Use:   opts.localizer.MustLocalize("kafka.list.cmd.use"),
 		Short: opts.localizer.MustLocalize("kafka.list.cmd.shortDescription"),
@@ -75,6 +82,14 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
+			for _, l := range labels {
+				key, value, ok := accountmgmtutil.ParseLabel(l)
+				if !ok {
+					return errors.New(opts.localizer.MustLocalize("kafka.label.common.error.invalidLabel", localize.NewEntry("Label", l)))
+				}
+				opts.labels[key] = value
+			}
+
 			return runList(opts)
 		},
 	}
@@ -83,6 +98,7 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().IntVarP(&opts.page, "page", "", 0, opts.localizer.MustLocalize("kafka.list.flag.page"))
 	cmd.Flags().IntVarP(&opts.limit, "limit", "", 100, opts.localizer.MustLocalize("kafka.list.flag.limit"))
 	cmd.Flags().StringVarP(&opts.search, "search", "", "", opts.localizer.MustLocalize("kafka.list.flag.search"))
+	cmd.Flags().StringArrayVar(&labels, "label", []string{}, opts.localizer.MustLocalize("kafka.list.flag.label"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -102,18 +118,24 @@ func runList(opts *options) error {
 
 	api := connection.API()
 
-	a := api.Kafka().GetKafkas(context.Background())
-	a = a.Page(strconv.Itoa(opts.page))
-	a = a.Size(strconv.Itoa(opts.limit))
-
+	var query string
 	if opts.search != "" {
-		query := buildQuery(opts.search)
+		query = buildQuery(opts.search)
 		logger.Debug(opts.localizer.MustLocalize("kafka.list.log.debug.filteringKafkaList", localize.NewEntry("Search", query)))
-		a = a.Search(query)
 	}
 
-	response, _, err := a.Execute()
-
+	var response kafkamgmtclient.KafkaRequestList
+	if len(opts.labels) > 0 {
+		response, err = listByLabels(api, query, opts)
+	} else {
+		a := api.Kafka().GetKafkas(context.Background())
+		a = a.Page(strconv.Itoa(opts.page))
+		a = a.Size(strconv.Itoa(opts.limit))
+		if query != "" {
+			a = a.Search(query)
+		}
+		response, _, err = a.Execute()
+	}
 	if err != nil {
 		return err
 	}
@@ -158,6 +180,60 @@ func mapResponseItemsToRows(kafkas []kafkamgmtclient.KafkaRequest) []kafkaRow {
 	return rows
 }
 
+// listByLabels returns the requested page of the Kafka instances which match the search query and have all the labels.
+// The labels are not known to the Kafka management API, so all the instances are listed and filtered before the page is taken.
+func listByLabels(api *api.API, query string, opts *options) (kafkamgmtclient.KafkaRequestList, error) {
+	ctx := context.Background()
+
+	instances, err := kafka.ListAll(ctx, api.Kafka(), query)
+	if err != nil {
+		return kafkamgmtclient.KafkaRequestList{}, err
+	}
+
+	ids := []string{}
+	for _, k := range instances {
+		ids = append(ids, k.GetId())
+	}
+
+	matchingIDs, err := accountmgmtutil.FilterKafkaIDsByLabels(ctx, api.AccountMgmt(), ids, opts.labels)
+	if err != nil {
+		return kafkamgmtclient.KafkaRequestList{}, err
+	}
+
+	matching := map[string]bool{}
+	for _, id := range matchingIDs {
+		matching[id] = true
+	}
+
+	items := []kafkamgmtclient.KafkaRequest{}
+	for _, k := range instances {
+		if matching[k.GetId()] {
+			items = append(items, k)
+		}
+	}
+
+	// the pages are numbered from 1, as in the Kafka management API
+	page := opts.page
+	if page < 1 {
+		page = 1
+	}
+	start, end := (page-1)*opts.limit, page*opts.limit
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return kafkamgmtclient.KafkaRequestList{
+		Kind:  "KafkaRequestList",
+		Page:  int32(page),
+		Size:  int32(end - start),
+		Total: int32(len(items)),
+		Items: items[start:end],
+	}, nil
+}
+
 func buildQuery(search string) string {
 
 	queryString := fmt.Sprintf(
@@ -0,0 +1,208 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/metrics/scrapeconfig"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
+	"github.com/redhat-developer/app-services-cli/pkg/common/sparkline"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	pkgMetrics "github.com/redhat-developer/app-services-cli/pkg/kafka/metrics"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), "prometheus")
+
+// metricsReport is the metrics of a Kafka instance over a time range
+type metricsReport struct {
+	KafkaID  string              `json:"kafka_id" yaml:"kafka_id"`
+	Range    string              `json:"range" yaml:"range"`
+	Interval string              `json:"interval" yaml:"interval"`
+	Metrics  []pkgMetrics.Series `json:"metrics" yaml:"metrics"`
+}
+
+// metricRow is a metric printed to a table
+type metricRow struct {
+	Name    string `header:"Metric"`
+	Unit    string `header:"Unit"`
+	Current string `header:"Current"`
+	Min     string `header:"Min"`
+	Max     string `header:"Max"`
+}
+
+// trendRow is a metricRow with a sparkline of its values, printed to a terminal
+type trendRow struct {
+	Name    string `header:"Metric"`
+	Unit    string `header:"Unit"`
+	Current string `header:"Current"`
+	Min     string `header:"Min"`
+	Max     string `header:"Max"`
+	Trend   string `header:"Trend"`
+}
+
+type Options struct {
+	kafkaID      string
+	timeRange    string
+	interval     string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	localizer  localize.Localizer
+}
+
+// NewMetricsCommand creates a command to view the metrics of a Kafka instance
+func NewMetricsCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.metrics.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.metrics.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.metrics.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.metrics.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runMetrics(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.timeRange, "range", "1h", opts.localizer.MustLocalize("kafka.metrics.flag.range.description"))
+	cmd.Flags().StringVar(&opts.interval, "interval", "5m", opts.localizer.MustLocalize("kafka.metrics.flag.interval.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("kafka.metrics.flag.output.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	cmd.AddCommand(scrapeconfig.NewScrapeConfigCommand(f))
+
+	return cmd
+}
+
+func runMetrics(opts *Options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().Kafka()
+	ctx := context.Background()
+
+	// the Prometheus text format holds the latest value of each series, so the time range is not used
+	if opts.outputFormat == "prometheus" {
+		samples, err := pkgMetrics.GetInstant(ctx, api, opts.kafkaID)
+		if err != nil {
+			return err
+		}
+		return pkgMetrics.WritePrometheus(opts.IO.Out, samples)
+	}
+
+	timeRange, err := age.Parse(opts.timeRange)
+	if err != nil || timeRange < time.Minute || timeRange > pkgMetrics.MaxRange {
+		return errors.New(opts.localizer.MustLocalize("kafka.metrics.error.invalidRange",
+			localize.NewEntry("Range", opts.timeRange),
+			localize.NewEntry("Max", age.Format(pkgMetrics.MaxRange)),
+		))
+	}
+
+	interval, err := age.Parse(opts.interval)
+	if err != nil || interval < time.Second || interval > pkgMetrics.MaxInterval {
+		return errors.New(opts.localizer.MustLocalize("kafka.metrics.error.invalidInterval",
+			localize.NewEntry("Interval", opts.interval),
+			localize.NewEntry("Max", age.Format(pkgMetrics.MaxInterval)),
+		))
+	}
+
+	series, err := pkgMetrics.GetRange(ctx, api, opts.kafkaID, timeRange, interval)
+	if err != nil {
+		return err
+	}
+
+	report := metricsReport{
+		KafkaID:  opts.kafkaID,
+		Range:    opts.timeRange,
+		Interval: opts.interval,
+		Metrics:  series,
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(report)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(report)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		printTable(opts, series)
+	}
+
+	return nil
+}
+
+func printTable(opts *Options, series []pkgMetrics.Series) {
+	rows := []metricRow{}
+	for _, s := range series {
+		rows = append(rows, metricRow{
+			Name:    s.Name,
+			Unit:    s.Unit,
+			Current: formatValue(s.Last()),
+			Min:     formatValue(s.Min()),
+			Max:     formatValue(s.Max()),
+		})
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		dump.Table(opts.IO.Out, rows)
+		return
+	}
+
+	trendRows := []trendRow{}
+	for i, r := range rows {
+		trendRows = append(trendRows, trendRow{
+			Name:    r.Name,
+			Unit:    r.Unit,
+			Current: r.Current,
+			Min:     r.Min,
+			Max:     r.Max,
+			Trend:   sparkline.Draw(series[i].Values()),
+		})
+	}
+	dump.Table(opts.IO.Out, trendRows)
+}
+
+// formatValue formats a value rounded to two decimals
+func formatValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,180 @@
+package scrapeconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/metrics"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccount/credentials"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputScrapeConfig   = "scrape-config"
+	outputServiceMonitor = "service-monitor"
+)
+
+var validOutputFormats = []string{outputScrapeConfig, outputServiceMonitor}
+
+type Options struct {
+	kafkaID         string
+	serviceAccount  string
+	credentialsFile string
+	namespace       string
+	outputFormat    string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	localizer  localize.Localizer
+}
+
+// NewScrapeConfigCommand creates a command to generate the configuration with which Prometheus
+// scrapes the metrics of a Kafka instance
+func NewScrapeConfigCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			if opts.credentialsFile == "" && !opts.IO.CanPrompt() {
+				return flag.RequiredWhenNonInteractiveError("credentials-file")
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runScrapeConfig(opts, cfg)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.serviceAccount, "service-account", "", opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.flag.serviceAccount.description"))
+	cmd.Flags().StringVar(&opts.credentialsFile, "credentials-file", "", opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.flag.credentialsFile.description"))
+	cmd.Flags().StringVarP(&opts.namespace, "namespace", "n", "", opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.flag.namespace.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", outputScrapeConfig, opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.flag.output.description"))
+
+	_ = cmd.MarkFlagRequired("service-account")
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+func runScrapeConfig(opts *Options, cfg *config.Config) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+	ctx := context.Background()
+
+	kafkaInstance, _, err := kafka.GetKafkaByID(ctx, api.Kafka(), opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	serviceAccount, httpRes, err := api.ServiceAccount().GetServiceAccountById(ctx, opts.serviceAccount).Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == 404 {
+			return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", opts.serviceAccount)))
+		}
+		return err
+	}
+
+	creds, err := getCredentials(opts, serviceAccount.GetClientId())
+	if err != nil {
+		return err
+	}
+
+	apiURL := cfg.APIUrl
+	if apiURL == "" {
+		apiURL = build.ProductionAPIURL
+	}
+	masAuthURL := cfg.MasAuthURL
+	if masAuthURL == "" {
+		masAuthURL = build.ProductionMasAuthURL
+	}
+
+	target := metrics.ScrapeTarget{
+		KafkaID:     kafkaInstance.GetId(),
+		KafkaName:   kafkaInstance.GetName(),
+		APIURL:      apiURL,
+		TokenURL:    masAuthURL + "/protocol/openid-connect/token",
+		Credentials: *creds,
+	}
+
+	var data []byte
+	if opts.outputFormat == outputServiceMonitor {
+		data, err = metrics.ServiceMonitor(target, opts.namespace)
+	} else {
+		data, err = metrics.ScrapeConfig(target)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(opts.IO.Out, string(data))
+	return err
+}
+
+// getCredentials reads the credentials of the service account with the given client ID from the credentials file,
+// or prompts for its client secret when there is no file
+func getCredentials(opts *Options, clientID string) (*credentials.Credentials, error) {
+	if opts.credentialsFile == "" {
+		creds := &credentials.Credentials{ClientID: clientID}
+		prompt := &survey.Password{
+			Message: opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.input.clientSecret.message", localize.NewEntry("ClientID", clientID)),
+		}
+		if err := survey.AskOne(prompt, &creds.ClientSecret, survey.WithValidator(survey.Required)); err != nil {
+			return nil, err
+		}
+		return creds, nil
+	}
+
+	creds, err := credentials.Read(opts.credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if creds.ClientID != clientID {
+		return nil, errors.New(opts.localizer.MustLocalize("kafka.metrics.scrapeConfig.error.credentialsMismatch",
+			localize.NewEntry("FilePath", opts.credentialsFile),
+			localize.NewEntry("ID", opts.serviceAccount),
+		))
+	}
+
+	return creds, nil
+}
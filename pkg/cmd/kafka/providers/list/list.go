@@ -0,0 +1,138 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudprovider/cloudproviderutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// providerRow is the details of a cloud provider needed to print to a table
+type providerRow struct {
+	ID          string `json:"id" yaml:"id" header:"ID"`
+	Name        string `json:"name" yaml:"name" header:"Name"`
+	DisplayName string `json:"display_name" yaml:"display_name" header:"Display Name"`
+	Status      string `json:"-" yaml:"-" header:"Status"`
+	Enabled     bool   `json:"enabled" yaml:"enabled"`
+}
+
+type options struct {
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+	Cache      func() *cache.Cache
+}
+
+// NewListCommand creates a command to list the cloud providers of Kafka instances
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Cache:      f.Cache,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.providers.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.providers.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.providers.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.providers.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("kafka.providers.list.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	var responseCache *cache.Cache
+	if opts.Cache != nil {
+		responseCache = opts.Cache()
+	}
+
+	cloudProviders, err := cloudproviderutil.List(context.Background(), conn.API().Kafka(), responseCache)
+	if err != nil {
+		return err
+	}
+
+	if len(cloudProviders) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("kafka.providers.list.log.info.noProviders"))
+		return nil
+	}
+
+	rows := mapProvidersToRows(cloudProviders)
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(rows)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(rows)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, rows)
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapProvidersToRows(cloudProviders []kafkamgmtclient.CloudProvider) []providerRow {
+	rows := []providerRow{}
+
+	for _, p := range cloudProviders {
+		row := providerRow{
+			ID:          p.GetId(),
+			Name:        p.GetName(),
+			DisplayName: p.GetDisplayName(),
+			Status:      "disabled",
+			Enabled:     p.GetEnabled(),
+		}
+		if row.Enabled {
+			row.Status = "enabled"
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
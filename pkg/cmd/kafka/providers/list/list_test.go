@@ -0,0 +1,90 @@
+package list
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func newKafkaMgmtMock() *kafkamgmtclient.DefaultApiMock {
+	newProvider := func(id string, displayName string, enabled bool) kafkamgmtclient.CloudProvider {
+		p := kafkamgmtclient.CloudProvider{}
+		p.SetId(id)
+		p.SetName(id)
+		p.SetDisplayName(displayName)
+		p.SetEnabled(enabled)
+		return p
+	}
+
+	mock := &kafkamgmtclient.DefaultApiMock{}
+	mock.GetCloudProvidersFunc = func(ctx context.Context) kafkamgmtclient.ApiGetCloudProvidersRequest {
+		return kafkamgmtclient.ApiGetCloudProvidersRequest{ApiService: mock}
+	}
+	mock.GetCloudProvidersExecuteFunc = func(kafkamgmtclient.ApiGetCloudProvidersRequest) (kafkamgmtclient.CloudProviderList, *http.Response, error) {
+		return kafkamgmtclient.CloudProviderList{Items: []kafkamgmtclient.CloudProvider{
+			newProvider("aws", "Amazon Web Services", true),
+			newProvider("gcp", "Google Cloud Platform", false),
+		}}, nil, nil
+	}
+	return mock
+}
+
+func TestListCommand(t *testing.T) {
+	mock := newKafkaMgmtMock()
+
+	tests := []struct {
+		name   string
+		args   []string
+		verify func(t *testing.T, out string)
+	}{
+		{
+			name: "table",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"Amazon Web Services", "enabled", "Google Cloud Platform", "disabled"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("output does not contain %q:\n%v", want, out)
+					}
+				}
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			verify: func(t *testing.T, out string) {
+				var rows []providerRow
+				if err := json.Unmarshal([]byte(out), &rows); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				want := []providerRow{
+					{ID: "aws", Name: "aws", DisplayName: "Amazon Web Services", Enabled: true},
+					{ID: "gcp", Name: "gcp", DisplayName: "Google Cloud Platform"},
+				}
+				if len(rows) != len(want) || rows[0] != want[0] || rows[1] != want[1] {
+					t.Errorf("providers = %+v, want %+v", rows, want)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			f := testutil.NewKafkaMgmtFactory(mock, &out)
+
+			cmd := NewListCommand(f)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.verify(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package providers contains commands for viewing the cloud providers of Kafka instances
+package providers
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/providers/list"
+	"github.com/spf13/cobra"
+)
+
+func NewProvidersCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("kafka.providers.cmd.use"),
+		Short: f.Localizer.MustLocalize("kafka.providers.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("kafka.providers.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,166 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudprovider/cloudproviderutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudregion/cloudregionutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// regionRow is the details of a cloud region needed to print to a table
+type regionRow struct {
+	Provider    string `json:"provider" yaml:"provider" header:"Provider"`
+	ID          string `json:"id" yaml:"id" header:"ID"`
+	DisplayName string `json:"display_name" yaml:"display_name" header:"Display Name"`
+	Status      string `json:"-" yaml:"-" header:"Status"`
+	Enabled     bool   `json:"enabled" yaml:"enabled"`
+}
+
+type options struct {
+	provider     string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+	Cache      func() *cache.Cache
+}
+
+// NewListCommand creates a command to list the cloud regions of Kafka instances
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+		Cache:      f.Cache,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.regions.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.regions.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.regions.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.regions.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.provider, flags.FlagProvider, "", opts.localizer.MustLocalize("kafka.regions.list.flag.provider.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("kafka.regions.list.flag.output.description"))
+
+	_ = cmd.RegisterFlagCompletionFunc(flags.FlagProvider, func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FetchCloudProviders(f)
+	})
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var responseCache *cache.Cache
+	if opts.Cache != nil {
+		responseCache = opts.Cache()
+	}
+
+	ctx := context.Background()
+	cloudProviders, err := cloudproviderutil.List(ctx, api.Kafka(), responseCache)
+	if err != nil {
+		return err
+	}
+
+	// the regions of all cloud providers are listed unless a provider is selected
+	if opts.provider != "" {
+		provider := cloudproviderutil.FindByID(cloudProviders, opts.provider)
+		if provider == nil {
+			return flag.InvalidValueError(flags.FlagProvider, opts.provider, cloudproviderutil.GetIDs(cloudProviders)...)
+		}
+		cloudProviders = []kafkamgmtclient.CloudProvider{*provider}
+	}
+
+	rows := []regionRow{}
+	for _, p := range cloudProviders {
+		regions, err := cloudregionutil.List(ctx, api.Kafka(), responseCache, p.GetId())
+		if err != nil {
+			return err
+		}
+		rows = append(rows, mapRegionsToRows(p.GetId(), regions)...)
+	}
+
+	if len(rows) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("kafka.regions.list.log.info.noRegions"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(rows)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(rows)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, rows)
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapRegionsToRows(providerID string, regions []kafkamgmtclient.CloudRegion) []regionRow {
+	rows := []regionRow{}
+
+	for _, r := range regions {
+		row := regionRow{
+			Provider:    providerID,
+			ID:          r.GetId(),
+			DisplayName: r.GetDisplayName(),
+			Status:      "disabled",
+			Enabled:     r.GetEnabled(),
+		}
+		if row.Enabled {
+			row.Status = "enabled"
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
@@ -0,0 +1,131 @@
+package list
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func newKafkaMgmtMock() *kafkamgmtclient.DefaultApiMock {
+	newProvider := func(id string) kafkamgmtclient.CloudProvider {
+		p := kafkamgmtclient.CloudProvider{}
+		p.SetId(id)
+		p.SetEnabled(true)
+		return p
+	}
+	newRegion := func(id string, displayName string, enabled bool) kafkamgmtclient.CloudRegion {
+		r := kafkamgmtclient.CloudRegion{}
+		r.SetId(id)
+		r.SetDisplayName(displayName)
+		r.SetEnabled(enabled)
+		return r
+	}
+	regions := map[string][]kafkamgmtclient.CloudRegion{
+		"aws": {newRegion("us-east-1", "US East, N. Virginia", true), newRegion("eu-west-1", "EU, Ireland", false)},
+		"gcp": {newRegion("us-central1", "Iowa", true)},
+	}
+
+	mock := &kafkamgmtclient.DefaultApiMock{}
+	mock.GetCloudProvidersFunc = func(ctx context.Context) kafkamgmtclient.ApiGetCloudProvidersRequest {
+		return kafkamgmtclient.ApiGetCloudProvidersRequest{ApiService: mock}
+	}
+	mock.GetCloudProvidersExecuteFunc = func(kafkamgmtclient.ApiGetCloudProvidersRequest) (kafkamgmtclient.CloudProviderList, *http.Response, error) {
+		return kafkamgmtclient.CloudProviderList{Items: []kafkamgmtclient.CloudProvider{newProvider("aws"), newProvider("gcp")}}, nil, nil
+	}
+
+	var providerID string
+	mock.GetCloudProviderRegionsFunc = func(ctx context.Context, id string) kafkamgmtclient.ApiGetCloudProviderRegionsRequest {
+		providerID = id
+		return kafkamgmtclient.ApiGetCloudProviderRegionsRequest{ApiService: mock}
+	}
+	mock.GetCloudProviderRegionsExecuteFunc = func(kafkamgmtclient.ApiGetCloudProviderRegionsRequest) (kafkamgmtclient.CloudRegionList, *http.Response, error) {
+		return kafkamgmtclient.CloudRegionList{Items: regions[providerID]}, nil, nil
+	}
+	return mock
+}
+
+func TestListCommand(t *testing.T) {
+	mock := newKafkaMgmtMock()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		verify  func(t *testing.T, out string)
+	}{
+		{
+			name: "table",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"US East, N. Virginia", "enabled", "EU, Ireland", "disabled", "Iowa"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("output does not contain %q:\n%v", want, out)
+					}
+				}
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			verify: func(t *testing.T, out string) {
+				var rows []regionRow
+				if err := json.Unmarshal([]byte(out), &rows); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				want := []regionRow{
+					{Provider: "aws", ID: "us-east-1", DisplayName: "US East, N. Virginia", Enabled: true},
+					{Provider: "aws", ID: "eu-west-1", DisplayName: "EU, Ireland"},
+					{Provider: "gcp", ID: "us-central1", DisplayName: "Iowa", Enabled: true},
+				}
+				if !reflect.DeepEqual(rows, want) {
+					t.Errorf("regions = %+v, want %+v", rows, want)
+				}
+			},
+		},
+		{
+			name: "regions of a single provider",
+			args: []string{"--provider", "gcp", "-o", "json"},
+			verify: func(t *testing.T, out string) {
+				var rows []regionRow
+				if err := json.Unmarshal([]byte(out), &rows); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				if len(rows) != 1 || rows[0].ID != "us-central1" {
+					t.Errorf("regions = %+v, want only the regions of gcp", rows)
+				}
+			},
+		},
+		{
+			name:    "unknown provider",
+			args:    []string{"--provider", "azure"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			f := testutil.NewKafkaMgmtFactory(mock, &out)
+
+			cmd := NewListCommand(f)
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.verify != nil {
+				tt.verify(t, out.String())
+			}
+		})
+	}
+}
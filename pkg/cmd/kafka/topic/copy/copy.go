@@ -0,0 +1,324 @@
+package copy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	topicutil "github.com/redhat-developer/app-services-cli/pkg/kafka/topic"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	fromKafkaID string
+	toKafkaID   string
+	pattern     string
+	dryRun      bool
+	force       bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// planRow contains the properties used to
+// print a single topic of the copy plan in a table row
+type planRow struct {
+	Name        string `header:"Name"`
+	Action      string `header:"Action"`
+	Partitions  int32  `header:"Partitions"`
+	Differences string `header:"Differences"`
+}
+
+// NewCopyTopicCommand gets a new command for copying topics between Kafka instances.
+func NewCopyTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.topic.copy.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.topic.copy.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.copy.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.copy.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.fromKafkaID == opts.toKafkaID {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.copy.error.sameInstance"))
+			}
+
+			if !opts.IO.CanPrompt() && !opts.force && !opts.dryRun {
+				return flag.RequiredWhenNonInteractiveError("yes")
+			}
+
+			return runCopy(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.fromKafkaID, "from-kafka", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.fromKafka.description"))
+	cmd.Flags().StringVar(&opts.toKafkaID, "to-kafka", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.toKafka.description"))
+	cmd.Flags().StringVar(&opts.pattern, "topic", "", opts.localizer.MustLocalize("kafka.topic.copy.flag.topic.description"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("kafka.topic.copy.flag.dryRun.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("kafka.topic.copy.flag.yes.description"))
+
+	_ = cmd.MarkFlagRequired("from-kafka")
+	_ = cmd.MarkFlagRequired("to-kafka")
+
+	for _, flagName := range []string{"from-kafka", "to-kafka"} {
+		_ = cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidKafkas(f, toComplete)
+		})
+	}
+	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	return cmd
+}
+
+// nolint:funlen
+func runCopy(opts *Options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	// the instances can be given by name or ID
+	if err = kafka.ResolveIDs(context.Background(), conn.API().Kafka(), &opts.fromKafkaID, &opts.toKafkaID); err != nil {
+		return err
+	}
+
+	if opts.fromKafkaID == opts.toKafkaID {
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.copy.error.sameInstance"))
+	}
+
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	matches, err := bulk.NewMatcher(opts.localizer, opts.pattern, "")
+	if err != nil {
+		return err
+	}
+
+	sourceAPI, sourceKafka, err := conn.API().KafkaAdmin(opts.fromKafkaID)
+	if err != nil {
+		return err
+	}
+
+	targetAPI, targetKafka, err := conn.API().KafkaAdmin(opts.toKafkaID)
+	if err != nil {
+		return err
+	}
+
+	sourceTopics, err := listTopics(opts, sourceAPI, sourceKafka.GetName())
+	if err != nil {
+		return err
+	}
+
+	if matches != nil {
+		filtered := []kafkainstanceclient.Topic{}
+		for _, t := range sourceTopics {
+			if matches(t.GetName()) {
+				filtered = append(filtered, t)
+			}
+		}
+		sourceTopics = filtered
+	}
+
+	fromTmplPair := localize.NewEntry("From", sourceKafka.GetName())
+	toTmplPair := localize.NewEntry("To", targetKafka.GetName())
+
+	if len(sourceTopics) == 0 {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.noTopicsFound", fromTmplPair))
+		return nil
+	}
+
+	targetTopics, err := listTopics(opts, targetAPI, targetKafka.GetName())
+	if err != nil {
+		return err
+	}
+
+	plan := topicutil.PlanCopy(sourceTopics, targetTopics)
+
+	logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.plan", fromTmplPair, toTmplPair))
+	dump.Table(opts.IO.Out, mapPlanToRows(opts.localizer, plan))
+	logger.Info("")
+
+	var conflicts int
+	for _, item := range plan {
+		if item.Action == topicutil.CopyActionConflict {
+			conflicts++
+		}
+	}
+	if conflicts > 0 {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.conflicts", localize.NewEntry("Count", conflicts)))
+	}
+
+	if opts.dryRun {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.dryRun"))
+		return nil
+	}
+
+	itemsByName := map[string]topicutil.CopyPlanItem{}
+	names := []string{}
+	for _, item := range plan {
+		// the partitions of a conflicting topic cannot be changed, so it is skipped
+		if item.Action == topicutil.CopyActionUnchanged || item.Action == topicutil.CopyActionConflict {
+			continue
+		}
+		itemsByName[item.Name] = item
+		names = append(names, item.Name)
+	}
+
+	if len(names) == 0 {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.nothingToCopy", toTmplPair))
+		return nil
+	}
+
+	if !opts.force {
+		var confirmCopy bool
+		promptConfirmCopy := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.topic.copy.input.confirmCopy.message", localize.NewEntry("Count", len(names)), toTmplPair),
+		}
+
+		if err = survey.AskOne(promptConfirmCopy, &confirmCopy); err != nil {
+			return err
+		}
+
+		if !confirmCopy {
+			logger.Debug(opts.localizer.MustLocalize("kafka.topic.copy.log.debug.copyNotConfirmed"))
+			return nil
+		}
+	}
+
+	results := bulk.Run(names, bulk.DefaultConcurrency, func(name string) error {
+		return applyPlanItem(opts, targetAPI, targetKafka.GetName(), itemsByName[name])
+	})
+
+	bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.copied"))
+
+	if failed := bulk.Failed(results); failed > 0 {
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.copy.error.someFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results))))
+	}
+
+	logger.Info(opts.localizer.MustLocalize("kafka.topic.copy.log.info.copySuccess", fromTmplPair, toTmplPair))
+
+	return nil
+}
+
+// create or update a topic in the target Kafka instance
+func applyPlanItem(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string, item topicutil.CopyPlanItem) error {
+	configEntryMap := map[string]*string{}
+	for key, value := range item.Config {
+		v := value
+		configEntryMap[key] = &v
+	}
+
+	if item.Action == topicutil.CopyActionCreate {
+		topicInput := kafkainstanceclient.NewTopicInput{
+			Name: item.Name,
+			Settings: kafkainstanceclient.TopicSettings{
+				NumPartitions: item.Partitions,
+				Config:        topicutil.CreateConfigEntries(configEntryMap),
+			},
+		}
+
+		_, res, err := api.CreateTopic(context.Background()).NewTopicInput(topicInput).Execute()
+		if err != nil && res != nil {
+			return mapError(opts, "create", res.StatusCode, item.Name, kafkaName, err)
+		}
+		return err
+	}
+
+	updateInput := kafkainstanceclient.UpdateTopicInput{
+		Config: topicutil.CreateConfigEntries(configEntryMap),
+	}
+
+	_, res, err := api.UpdateTopic(context.Background(), item.Name).UpdateTopicInput(updateInput).Execute()
+	if err != nil && res != nil {
+		return mapError(opts, "update", res.StatusCode, item.Name, kafkaName, err)
+	}
+	return err
+}
+
+func listTopics(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string) ([]kafkainstanceclient.Topic, error) {
+	topics, httpRes, err := cmdutil.ListTopics(api)
+	if err != nil {
+		if httpRes == nil {
+			return nil, err
+		}
+		return nil, mapError(opts, "list", httpRes.StatusCode, "", kafkaName, err)
+	}
+
+	return topics, nil
+}
+
+func mapError(opts *Options, operation string, statusCode int, topicName string, kafkaName string, err error) error {
+	operationTmplPair := localize.NewEntry("Operation", operation)
+	switch statusCode {
+	case 401:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unauthorized", operationTmplPair))
+	case 403:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.forbidden", operationTmplPair))
+	case 409:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.create.error.conflictError", localize.NewEntry("TopicName", topicName), localize.NewEntry("InstanceName", kafkaName)))
+	case 500:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.internalServerError"))
+	case 503:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaName)))
+	default:
+		return err
+	}
+}
+
+func mapPlanToRows(localizer localize.Localizer, plan []topicutil.CopyPlanItem) []planRow {
+	rows := []planRow{}
+
+	for _, item := range plan {
+		differences := make([]string, 0, len(item.Differences))
+		for _, d := range item.Differences {
+			differences = append(differences, formatDifference(localizer, d))
+		}
+
+		row := planRow{
+			Name:        item.Name,
+			Action:      localizer.MustLocalize("kafka.topic.copy.action." + item.Action),
+			Partitions:  item.Partitions,
+			Differences: strings.Join(differences, ", "),
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func formatDifference(localizer localize.Localizer, d topicutil.CopyDifference) string {
+	sourceTmplPair := localize.NewEntry("Source", d.Source)
+	targetTmplPair := localize.NewEntry("Target", d.Target)
+	if d.Key == topicutil.CopyDifferencePartitions {
+		return localizer.MustLocalize("kafka.topic.copy.difference.partitions", sourceTmplPair, targetTmplPair)
+	}
+	return localizer.MustLocalize("kafka.topic.copy.difference.config", localize.NewEntry("Key", d.Key), sourceTmplPair, targetTmplPair)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	topicutil "github.com/redhat-developer/app-services-cli/pkg/kafka/topic"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
@@ -48,6 +49,7 @@ type Options struct {
 	Connection factory.ConnectionFunc
 	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
+	Cache      func() *cache.Cache
 }
 
 // NewCreateTopicCommand gets a new command for creating kafka topic.
@@ -58,6 +60,7 @@ func NewCreateTopicCommand(f *factory.Factory) *cobra.Command {
 		Logger:     f.Logger,
 		IO:         f.IOStreams,
 		localizer:  f.Localizer,
+		Cache:      f.Cache,
 	}
 
 	cmd := &cobra.Command{
@@ -189,6 +192,10 @@ func runCmd(opts *Options) error {
 
 	logger.Info(opts.localizer.MustLocalize("kafka.topic.create.log.info.topicCreated", localize.NewEntry("TopicName", response.GetName()), localize.NewEntry("InstanceName", kafkaInstance.GetName())))
 
+	if opts.Cache != nil {
+		_ = opts.Cache().Remove(cache.TopicsKey(opts.kafkaID))
+	}
+
 	switch opts.outputFormat {
 	case "json":
 		data, _ := json.Marshal(response)
@@ -6,9 +6,12 @@ import (
 	"fmt"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
 
@@ -20,18 +23,21 @@ import (
 )
 
 type Options struct {
-	topicName string
-	kafkaID   string
-	force     bool
+	topicNames []string
+	pattern    string
+	regex      string
+	kafkaID    string
+	force      bool
 
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Connection factory.ConnectionFunc
 	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
+	Cache      func() *cache.Cache
 }
 
-// NewDeleteTopicCommand gets a new command for deleting a kafka topic.
+// NewDeleteTopicCommand gets a new command for deleting kafka topics.
 func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 	opts := &Options{
 		Connection: f.Connection,
@@ -39,6 +45,7 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 		Logger:     f.Logger,
 		IO:         f.IOStreams,
 		localizer:  f.Localizer,
+		Cache:      f.Cache,
 	}
 
 	cmd := &cobra.Command{
@@ -46,7 +53,7 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 		Short:   opts.localizer.MustLocalize("kafka.topic.delete.cmd.shortDescription"),
 		Long:    opts.localizer.MustLocalize("kafka.topic.delete.cmd.longDescription"),
 		Example: opts.localizer.MustLocalize("kafka.topic.delete.cmd.example"),
-		Args:    cobra.ExactValidArgs(1),
+		Args:    cobra.ArbitraryArgs,
 		// Dynamic completion of the topic name
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return cmdutil.FilterValidTopicNameArgs(f, toComplete)
@@ -56,8 +63,13 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 				return errors.New(opts.localizer.MustLocalize("flag.error.requiredWhenNonInteractive", localize.NewEntry("Flag", "yes")))
 			}
 
-			if len(args) > 0 {
-				opts.topicName = args[0]
+			if len(args) == 0 && opts.pattern == "" && opts.regex == "" {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.delete.error.noTopicsSpecified"))
+			}
+
+			opts.topicNames, err = bulk.CollectArgs(args, opts.IO.In)
+			if err != nil {
+				return err
 			}
 
 			if opts.kafkaID != "" {
@@ -80,6 +92,8 @@ func NewDeleteTopicCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("kafka.topic.delete.flag.yes.description"))
+	cmd.Flags().StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("kafka.topic.delete.flag.pattern.description"))
+	cmd.Flags().StringVar(&opts.regex, "regex", "", opts.localizer.MustLocalize("kafka.topic.delete.flag.regex.description"))
 
 	return cmd
 }
@@ -101,61 +115,144 @@ func runCmd(opts *Options) error {
 		return err
 	}
 
-	// perform delete topic API request
-	_, httpRes, err := api.GetTopic(context.Background(), opts.topicName).
-		Execute()
-
-	topicNameTmplPair := localize.NewEntry("TopicName", opts.topicName)
 	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaInstance.GetName())
+
+	matches, err := bulk.NewMatcher(opts.localizer, opts.pattern, opts.regex)
 	if err != nil {
-		if httpRes == nil {
+		return err
+	}
+
+	if matches != nil {
+		names, err := cmdutil.ListTopicNames(api)
+		if err != nil {
 			return err
 		}
-		if httpRes.StatusCode == 404 {
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.topicNotFoundError", topicNameTmplPair, kafkaNameTmplPair))
+
+		for _, name := range names {
+			if matches(name) {
+				opts.topicNames = append(opts.topicNames, name)
+			}
 		}
 	}
 
+	topicNames := bulk.Unique(opts.topicNames)
+	if len(topicNames) == 0 {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.noTopicsMatched", kafkaNameTmplPair))
+		return nil
+	}
+
+	// a single topic keeps the original flow of checking
+	// that the topic exists and confirming its name
+	if len(topicNames) == 1 {
+		return deleteSingleTopic(opts, api, kafkaInstance.GetName(), topicNames[0])
+	}
+
 	if !opts.force {
-		var promptConfirmName = &survey.Input{
-			Message: opts.localizer.MustLocalize("kafka.topic.delete.input.name.message"),
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicsToDelete", kafkaNameTmplPair))
+		for _, name := range topicNames {
+			logger.Info("  " + name)
 		}
-		var userConfirmedName string
-		if err = survey.AskOne(promptConfirmName, &userConfirmedName); err != nil {
+
+		var confirmDelete bool
+		promptConfirmDelete := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("kafka.topic.delete.input.confirmDeleteMultiple.message", localize.NewEntry("Count", len(topicNames))),
+		}
+		if err = survey.AskOne(promptConfirmDelete, &confirmDelete); err != nil {
 			return err
 		}
 
-		if userConfirmedName != opts.topicName {
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.delete.error.mismatchedNameConfirmation", localize.NewEntry("ConfirmedName", userConfirmedName), localize.NewEntry("ActualName", opts.topicName)))
+		if !confirmDelete {
+			logger.Debug(opts.localizer.MustLocalize("kafka.topic.delete.log.debug.deleteNotConfirmed"))
+			return nil
 		}
 	}
 
-	// perform delete topic API request
-	httpRes, err = api.DeleteTopic(context.Background(), opts.topicName).
+	results := bulk.Run(topicNames, bulk.DefaultConcurrency, func(name string) error {
+		return deleteTopic(opts, api, kafkaInstance.GetName(), name)
+	})
+
+	bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.deleted"))
+
+	if failed := bulk.Failed(results); failed > 0 {
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.delete.error.someFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results))))
+	}
+
+	return nil
+}
+
+func deleteSingleTopic(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string, topicName string) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	_, httpRes, err := api.GetTopic(context.Background(), topicName).
 		Execute()
+
+	topicNameTmplPair := localize.NewEntry("TopicName", topicName)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaName)
 	if err != nil {
 		if httpRes == nil {
 			return err
 		}
+		if httpRes.StatusCode == 404 {
+			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.topicNotFoundError", topicNameTmplPair, kafkaNameTmplPair))
+		}
+	}
 
-		operationTmplPair := localize.NewEntry("Operation", "delete")
-		switch httpRes.StatusCode {
-		case 404:
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair))
-		case 401:
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unauthorized", operationTmplPair))
-		case 403:
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.forbidden", operationTmplPair))
-		case 500:
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.internalServerError"))
-		case 503:
-			return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaInstance.GetName())))
-		default:
+	if !opts.force {
+		var promptConfirmName = &survey.Input{
+			Message: opts.localizer.MustLocalize("kafka.topic.delete.input.name.message"),
+		}
+		var userConfirmedName string
+		if err = survey.AskOne(promptConfirmName, &userConfirmedName); err != nil {
 			return err
 		}
+
+		if userConfirmedName != topicName {
+			return errors.New(opts.localizer.MustLocalize("kafka.topic.delete.error.mismatchedNameConfirmation", localize.NewEntry("ConfirmedName", userConfirmedName), localize.NewEntry("ActualName", topicName)))
+		}
+	}
+
+	if err = deleteTopic(opts, api, kafkaName, topicName); err != nil {
+		return err
 	}
 
 	logger.Info(opts.localizer.MustLocalize("kafka.topic.delete.log.info.topicDeleted", topicNameTmplPair, kafkaNameTmplPair))
 
 	return nil
 }
+
+// perform delete topic API request
+func deleteTopic(opts *Options, api kafkainstanceclient.DefaultApi, kafkaName string, topicName string) error {
+	httpRes, err := api.DeleteTopic(context.Background(), topicName).
+		Execute()
+	if err == nil {
+		if opts.Cache != nil {
+			_ = opts.Cache().Remove(cache.TopicsKey(opts.kafkaID))
+		}
+		return nil
+	}
+
+	if httpRes == nil {
+		return err
+	}
+
+	topicNameTmplPair := localize.NewEntry("TopicName", topicName)
+	kafkaNameTmplPair := localize.NewEntry("InstanceName", kafkaName)
+	operationTmplPair := localize.NewEntry("Operation", "delete")
+	switch httpRes.StatusCode {
+	case 404:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.notFoundError", topicNameTmplPair, kafkaNameTmplPair))
+	case 401:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unauthorized", operationTmplPair))
+	case 403:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.forbidden", operationTmplPair))
+	case 500:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.internalServerError"))
+	case 503:
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.unableToConnectToKafka", localize.NewEntry("Name", kafkaName)))
+	default:
+		return err
+	}
+}
@@ -51,7 +51,7 @@ func NewDescribeTopicCommand(f *factory.Factory) *cobra.Command {
 		Short:   opts.localizer.MustLocalize("kafka.topic.describe.cmd.shortDescription"),
 		Long:    opts.localizer.MustLocalize("kafka.topic.describe.cmd.longDescription"),
 		Example: opts.localizer.MustLocalize("kafka.topic.describe.cmd.example"),
-		Args:    cobra.ExactValidArgs(1),
+		Args:    cobra.RangeArgs(0, 1),
 		// dynamic completion of topic names
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			return cmdutil.FilterValidTopicNameArgs(f, toComplete)
@@ -76,6 +76,12 @@ func NewDescribeTopicCommand(f *factory.Factory) *cobra.Command {
 				return err
 			}
 
+			if opts.topicName == "" {
+				if opts.topicName = cfg.DefaultTopic(); opts.topicName == "" {
+					return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.topicNameRequired"))
+				}
+			}
+
 			if !cfg.HasKafka() {
 				return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.noKafkaSelected"))
 			}
@@ -0,0 +1,291 @@
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/schema"
+	topicutil "github.com/redhat-developer/app-services-cli/pkg/kafka/topic"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultLimit = 20
+
+type Options struct {
+	topicName    string
+	kafkaID      string
+	partition    int
+	offset       int64
+	fromTime     string
+	toTime       string
+	limit        int
+	keyFilter    string
+	valueFilter  string
+	regex        bool
+	valueFormat  string
+	outputFormat string
+
+	schemaFile        string
+	schemaRegistryURL string
+	protoMessage      string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// messageRow contains the properties used to print a single message
+type messageRow struct {
+	Partition int               `json:"partition" yaml:"partition"`
+	Offset    int64             `json:"offset" yaml:"offset"`
+	Timestamp string            `json:"timestamp" yaml:"timestamp"`
+	Key       string            `json:"key,omitempty" yaml:"key,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Value     string            `json:"value" yaml:"value"`
+}
+
+// NewMessagesTopicCommand gets a new command for browsing the messages of a topic.
+func NewMessagesTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.topic.messages.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.topic.messages.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.messages.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.messages.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		// dynamic completion of topic names
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidTopicNameArgs(f, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.topicName = args[0]
+			}
+
+			if opts.outputFormat != "" {
+				if err := flag.ValidateOutput(opts.outputFormat); err != nil {
+					return err
+				}
+			}
+
+			if !flagutil.IsValidInput(opts.valueFormat, topicutil.ValidDecodings...) {
+				return flag.InvalidValueError("value-format", opts.valueFormat, topicutil.ValidDecodings...)
+			}
+
+			if opts.limit < 0 {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.messages.error.invalidLimit"))
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if opts.topicName == "" {
+				if opts.topicName = cfg.DefaultTopic(); opts.topicName == "" {
+					return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.topicNameRequired"))
+				}
+			}
+
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.noKafkaSelected"))
+			}
+
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runCmd(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.partition, "partition", topicutil.AllPartitions, opts.localizer.MustLocalize("kafka.topic.messages.flag.partition.description"))
+	cmd.Flags().Int64Var(&opts.offset, "offset", topicutil.NoOffset, opts.localizer.MustLocalize("kafka.topic.messages.flag.offset.description"))
+	cmd.Flags().StringVar(&opts.fromTime, "from-time", "", opts.localizer.MustLocalize("kafka.topic.messages.flag.fromTime.description"))
+	cmd.Flags().StringVar(&opts.toTime, "to-time", "", opts.localizer.MustLocalize("kafka.topic.messages.flag.toTime.description"))
+	cmd.Flags().IntVar(&opts.limit, "limit", defaultLimit, opts.localizer.MustLocalize("kafka.topic.messages.flag.limit.description"))
+	cmd.Flags().StringVar(&opts.keyFilter, "key-filter", "", opts.localizer.MustLocalize("kafka.topic.messages.flag.keyFilter.description"))
+	cmd.Flags().StringVar(&opts.valueFilter, "value-filter", "", opts.localizer.MustLocalize("kafka.topic.messages.flag.valueFilter.description"))
+	cmd.Flags().BoolVar(&opts.regex, "regex", false, opts.localizer.MustLocalize("kafka.topic.messages.flag.regex.description"))
+	cmd.Flags().StringVar(&opts.valueFormat, "value-format", topicutil.DecodeString, opts.localizer.MustLocalize("kafka.topic.messages.flag.valueFormat.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("kafka.topic.messages.flag.output.description"))
+	cmd.Flags().StringVar(&opts.schemaFile, "schema-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.schemaFile.description"))
+	cmd.Flags().StringVar(&opts.schemaRegistryURL, "schema-registry-url", "", opts.localizer.MustLocalize("kafka.topic.common.flag.schemaRegistryURL.description"))
+	cmd.Flags().StringVar(&opts.protoMessage, "proto-message", "", opts.localizer.MustLocalize("kafka.topic.common.flag.protoMessage.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "value-format", topicutil.ValidDecodings)
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *Options) error {
+	browseOpts := &topicutil.BrowseOptions{
+		Partition: opts.partition,
+		Offset:    opts.offset,
+		Limit:     opts.limit,
+	}
+
+	now := time.Now()
+	var err error
+	if opts.fromTime != "" {
+		if browseOpts.FromTime, err = age.ParseTime(opts.fromTime, now); err != nil {
+			return err
+		}
+	}
+	if opts.toTime != "" {
+		if browseOpts.ToTime, err = age.ParseTime(opts.toTime, now); err != nil {
+			return err
+		}
+	}
+
+	browseOpts.Matches, err = topicutil.NewMessageMatcher(opts.keyFilter, opts.valueFilter, opts.regex)
+	if err != nil {
+		return err
+	}
+
+	// values encoded with a schema are converted to JSON
+	var deserializer *schema.Deserializer
+	if opts.schemaFile != "" || opts.schemaRegistryURL != "" {
+		var registry *schema.Registry
+		if opts.schemaRegistryURL != "" {
+			registry = schema.NewRegistry(opts.schemaRegistryURL)
+		}
+		var localSchema *schema.Schema
+		if opts.schemaFile != "" {
+			if localSchema, err = schema.LoadFile(opts.schemaFile); err != nil {
+				return err
+			}
+		}
+		deserializer = schema.NewDeserializer(registry, localSchema, opts.protoMessage)
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	dataPlaneConfig, kafkaInstance, err := conn.API().KafkaDataPlane(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	source := dataplane.NewReader(dataPlaneConfig, opts.topicName)
+	defer source.Close()
+
+	messages, err := topicutil.BrowseMessages(context.Background(), source, browseOpts)
+	if errors.Is(err, topicutil.ErrPartitionNotFound) {
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.messages.error.partitionNotFound", localize.NewEntry("Partition", opts.partition), localize.NewEntry("TopicName", opts.topicName)))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("kafka.topic.messages.log.info.noMessages", localize.NewEntry("TopicName", opts.topicName), localize.NewEntry("InstanceName", kafkaInstance.GetName())))
+		return nil
+	}
+
+	if deserializer != nil {
+		for i, m := range messages {
+			value, err := deserializer.Deserialize(m.Value)
+			if err != nil {
+				// the value is shown as it is when it cannot be deserialized
+				logger.Debug(opts.localizer.MustLocalize("kafka.topic.messages.log.debug.deserializeFailed", localize.NewEntry("Partition", m.Partition), localize.NewEntry("Offset", m.Offset), localize.NewEntry("Error", err)))
+				continue
+			}
+			messages[i].Value = value
+		}
+	}
+
+	rows := mapMessagesToRows(messages, opts.valueFormat)
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(rows)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(rows)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		for _, row := range rows {
+			printMessage(opts, row)
+		}
+	}
+
+	return nil
+}
+
+func printMessage(opts *Options, row messageRow) {
+	fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("kafka.topic.messages.output.metadata",
+		localize.NewEntry("Partition", row.Partition),
+		localize.NewEntry("Offset", row.Offset),
+		localize.NewEntry("Timestamp", row.Timestamp),
+	))
+	if row.Key != "" {
+		fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("kafka.topic.messages.output.key", localize.NewEntry("Key", row.Key)))
+	}
+	keys := []string{}
+	for key := range row.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("kafka.topic.messages.output.header", localize.NewEntry("Key", key), localize.NewEntry("Value", row.Headers[key])))
+	}
+	fmt.Fprintf(opts.IO.Out, "%v\n\n", row.Value)
+}
+
+func mapMessagesToRows(messages []dataplane.Record, valueFormat string) []messageRow {
+	rows := []messageRow{}
+
+	for _, m := range messages {
+		row := messageRow{
+			Partition: m.Partition,
+			Offset:    m.Offset,
+			Timestamp: m.Time.UTC().Format(time.RFC3339Nano),
+			Key:       string(m.Key),
+			Value:     topicutil.DecodeValue(m.Value, valueFormat),
+		}
+		if len(m.Headers) > 0 {
+			row.Headers = map[string]string{}
+			for _, h := range m.Headers {
+				row.Headers[h.Key] = string(h.Value)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
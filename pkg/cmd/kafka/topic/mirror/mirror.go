@@ -0,0 +1,217 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/mirror"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	fromKafkaID    string
+	toKafkaID      string
+	topicName      string
+	toTopicName    string
+	checkpointFile string
+	rateLimit      int
+	batchSize      int
+	follow         bool
+	reset          bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewMirrorTopicCommand gets a new command for mirroring the records of a topic to another Kafka instance.
+func NewMirrorTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.topic.mirror.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.topic.mirror.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.mirror.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.mirror.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.toTopicName == "" {
+				opts.toTopicName = opts.topicName
+			}
+
+			if opts.rateLimit < 0 {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.mirror.error.invalidRateLimit"))
+			}
+
+			return runMirror(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.fromKafkaID, "from", "", opts.localizer.MustLocalize("kafka.topic.mirror.flag.from.description"))
+	cmd.Flags().StringVar(&opts.toKafkaID, "to", "", opts.localizer.MustLocalize("kafka.topic.mirror.flag.to.description"))
+	cmd.Flags().StringVar(&opts.topicName, "topic", "", opts.localizer.MustLocalize("kafka.topic.mirror.flag.topic.description"))
+	cmd.Flags().StringVar(&opts.toTopicName, "to-topic", "", opts.localizer.MustLocalize("kafka.topic.mirror.flag.toTopic.description"))
+	cmd.Flags().StringVar(&opts.checkpointFile, "checkpoint-file", "", opts.localizer.MustLocalize("kafka.topic.mirror.flag.checkpointFile.description"))
+	cmd.Flags().IntVar(&opts.rateLimit, "rate-limit", 0, opts.localizer.MustLocalize("kafka.topic.mirror.flag.rateLimit.description"))
+	cmd.Flags().IntVar(&opts.batchSize, "batch-size", mirror.DefaultBatchSize, opts.localizer.MustLocalize("kafka.topic.mirror.flag.batchSize.description"))
+	cmd.Flags().BoolVar(&opts.follow, "follow", false, opts.localizer.MustLocalize("kafka.topic.mirror.flag.follow.description"))
+	cmd.Flags().BoolVar(&opts.reset, "reset", false, opts.localizer.MustLocalize("kafka.topic.mirror.flag.reset.description"))
+
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
+	_ = cmd.MarkFlagRequired("topic")
+
+	for _, flagName := range []string{"from", "to"} {
+		_ = cmd.RegisterFlagCompletionFunc(flagName, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidKafkas(f, toComplete)
+		})
+	}
+	_ = cmd.RegisterFlagCompletionFunc("topic", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FilterValidTopicNameArgs(f, toComplete)
+	})
+
+	return cmd
+}
+
+// nolint:funlen
+func runMirror(opts *Options) error {
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	// the instances can be given by name or ID
+	if err = kafka.ResolveIDs(context.Background(), conn.API().Kafka(), &opts.fromKafkaID, &opts.toKafkaID); err != nil {
+		return err
+	}
+
+	if opts.fromKafkaID == opts.toKafkaID && opts.topicName == opts.toTopicName {
+		return errors.New(opts.localizer.MustLocalize("kafka.topic.mirror.error.sameTopic"))
+	}
+
+	if opts.checkpointFile == "" {
+		if opts.checkpointFile, err = defaultCheckpointFile(opts); err != nil {
+			return err
+		}
+	}
+
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	sourceConfig, sourceKafka, err := conn.API().KafkaDataPlane(opts.fromKafkaID)
+	if err != nil {
+		return err
+	}
+
+	targetConfig, targetKafka, err := conn.API().KafkaDataPlane(opts.toKafkaID)
+	if err != nil {
+		return err
+	}
+
+	// the target topic is not created automatically, as its settings could differ from the source topic
+	targetAPI, _, err := conn.API().KafkaAdmin(opts.toKafkaID)
+	if err != nil {
+		return err
+	}
+	_, httpRes, err := targetAPI.GetTopic(context.Background(), opts.toTopicName).Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == 404 {
+			return errors.New(opts.localizer.MustLocalize("kafka.topic.mirror.error.targetTopicNotFound", localize.NewEntry("TopicName", opts.toTopicName), localize.NewEntry("InstanceName", targetKafka.GetName())))
+		}
+		return err
+	}
+
+	checkpoint := mirror.NewCheckpoint(opts.fromKafkaID, opts.toKafkaID, opts.topicName, opts.toTopicName)
+	if !opts.reset {
+		var savedCheckpoint *mirror.Checkpoint
+		savedCheckpoint, err = mirror.LoadCheckpoint(opts.checkpointFile)
+		if err != nil {
+			return err
+		}
+		if savedCheckpoint != nil {
+			if !savedCheckpoint.Matches(checkpoint) {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.mirror.error.checkpointMismatch", localize.NewEntry("File", opts.checkpointFile)))
+			}
+			checkpoint = savedCheckpoint
+			logger.Info(opts.localizer.MustLocalize("kafka.topic.mirror.log.info.resuming", localize.NewEntry("File", opts.checkpointFile)))
+		}
+	}
+
+	source := dataplane.NewReader(sourceConfig, opts.topicName)
+	defer source.Close()
+
+	sink := dataplane.NewWriter(targetConfig, opts.toTopicName)
+	defer sink.Close()
+
+	// stop gracefully on interrupt, the checkpoint is kept so the mirror can be resumed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logger.Info(opts.localizer.MustLocalize("kafka.topic.mirror.log.info.mirroring",
+		localize.NewEntry("Topic", opts.topicName),
+		localize.NewEntry("From", sourceKafka.GetName()),
+		localize.NewEntry("ToTopic", opts.toTopicName),
+		localize.NewEntry("To", targetKafka.GetName()),
+	))
+
+	showProgress := opts.IO.IsStderrTTY()
+	progress, err := mirror.Run(ctx, source, sink, &mirror.Options{
+		BatchSize:  opts.batchSize,
+		RateLimit:  opts.rateLimit,
+		Follow:     opts.follow,
+		Checkpoint: checkpoint,
+		SaveCheckpoint: func(c *mirror.Checkpoint) error {
+			return c.Save(opts.checkpointFile)
+		},
+		OnProgress: func(p mirror.Progress) {
+			if showProgress {
+				fmt.Fprintf(opts.IO.ErrOut, "\r%v", opts.localizer.MustLocalize("kafka.topic.mirror.log.info.progress", localize.NewEntry("Mirrored", p.Mirrored), localize.NewEntry("Remaining", p.Remaining)))
+			}
+		},
+	})
+	if showProgress && progress.Mirrored > 0 {
+		fmt.Fprintln(opts.IO.ErrOut)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("kafka.topic.mirror.log.info.mirrorSuccess", localize.NewEntry("Count", progress.Mirrored), localize.NewEntry("File", opts.checkpointFile)))
+
+	return nil
+}
+
+// the checkpoint file is stored in the config directory, one for each mirrored topic
+func defaultCheckpointFile(opts *Options) (string, error) {
+	dir, err := config.DefaultDir()
+	if err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%v-%v-%v-%v.json", opts.fromKafkaID, opts.topicName, opts.toKafkaID, opts.toTopicName)
+
+	return filepath.Join(dir, "mirror", name), nil
+}
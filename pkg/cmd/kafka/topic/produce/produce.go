@@ -0,0 +1,215 @@
+package produce
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/schema"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	topicName         string
+	kafkaID           string
+	key               string
+	value             string
+	headers           []string
+	partition         int
+	schemaFile        string
+	schemaRegistryURL string
+	schemaID          int
+	protoMessage      string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewProduceTopicCommand gets a new command for producing messages to a topic.
+func NewProduceTopicCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Connection: f.Connection,
+		Config:     f.Config,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.topic.produce.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.topic.produce.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.topic.produce.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.topic.produce.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		// dynamic completion of topic names
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidTopicNameArgs(f, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.topicName = args[0]
+			}
+
+			for _, h := range opts.headers {
+				if !strings.Contains(h, "=") {
+					return flag.InvalidValueError("header", h)
+				}
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if opts.topicName == "" {
+				if opts.topicName = cfg.DefaultTopic(); opts.topicName == "" {
+					return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.topicNameRequired"))
+				}
+			}
+
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.common.error.noKafkaSelected"))
+			}
+
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.key, "key", "", opts.localizer.MustLocalize("kafka.topic.produce.flag.key.description"))
+	cmd.Flags().StringVar(&opts.value, "value", "", opts.localizer.MustLocalize("kafka.topic.produce.flag.value.description"))
+	cmd.Flags().StringArrayVar(&opts.headers, "header", []string{}, opts.localizer.MustLocalize("kafka.topic.produce.flag.header.description"))
+	cmd.Flags().IntVar(&opts.partition, "partition", -1, opts.localizer.MustLocalize("kafka.topic.produce.flag.partition.description"))
+	cmd.Flags().StringVar(&opts.schemaFile, "schema-file", "", opts.localizer.MustLocalize("kafka.topic.common.flag.schemaFile.description"))
+	cmd.Flags().StringVar(&opts.schemaRegistryURL, "schema-registry-url", "", opts.localizer.MustLocalize("kafka.topic.common.flag.schemaRegistryURL.description"))
+	cmd.Flags().IntVar(&opts.schemaID, "schema-id", 0, opts.localizer.MustLocalize("kafka.topic.produce.flag.schemaID.description"))
+	cmd.Flags().StringVar(&opts.protoMessage, "proto-message", "", opts.localizer.MustLocalize("kafka.topic.common.flag.protoMessage.description"))
+
+	return cmd
+}
+
+// nolint:funlen
+func runCmd(opts *Options) error {
+	values := []string{opts.value}
+	if opts.value == "" {
+		var err error
+		if values, err = readValues(opts); err != nil {
+			return err
+		}
+	}
+
+	var serializer *schema.Serializer
+	if opts.schemaFile != "" || opts.schemaRegistryURL != "" {
+		var registry *schema.Registry
+		if opts.schemaRegistryURL != "" {
+			registry = schema.NewRegistry(opts.schemaRegistryURL)
+		}
+
+		s, err := schema.ResolveSchema(registry, opts.schemaFile, opts.topicName, opts.schemaID)
+		if err != nil {
+			return err
+		}
+
+		if serializer, err = schema.NewSerializer(s, opts.protoMessage); err != nil {
+			return err
+		}
+	}
+
+	headers := []dataplane.Header{}
+	for _, h := range opts.headers {
+		pair := strings.SplitN(h, "=", 2)
+		headers = append(headers, dataplane.Header{Key: pair[0], Value: []byte(pair[1])})
+	}
+
+	records := []dataplane.Record{}
+	for i, v := range values {
+		value := []byte(v)
+		if serializer != nil {
+			var err error
+			if value, err = serializer.Serialize(value); err != nil {
+				return errors.New(opts.localizer.MustLocalize("kafka.topic.produce.error.serialize", localize.NewEntry("Line", i+1), localize.NewEntry("Error", err)))
+			}
+		}
+
+		record := dataplane.Record{
+			Partition: opts.partition,
+			Value:     value,
+			Headers:   headers,
+		}
+		if opts.key != "" {
+			record.Key = []byte(opts.key)
+		}
+
+		records = append(records, record)
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	dataPlaneConfig, kafkaInstance, err := conn.API().KafkaDataPlane(opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	writer := dataplane.NewWriter(dataPlaneConfig, opts.topicName)
+	defer writer.Close()
+
+	if err = writer.Write(context.Background(), records); err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("kafka.topic.produce.log.info.produceSuccess",
+		localize.NewEntry("Count", len(records)),
+		localize.NewEntry("TopicName", opts.topicName),
+		localize.NewEntry("InstanceName", kafkaInstance.GetName()),
+	))
+
+	return nil
+}
+
+// read the message values from standard input, one per line
+func readValues(opts *Options) ([]string, error) {
+	if opts.IO.IsStdinTTY() {
+		return nil, errors.New(opts.localizer.MustLocalize("kafka.topic.produce.error.noValue"))
+	}
+
+	values := []string{}
+	scanner := bufio.NewScanner(opts.IO.In)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			values = append(values, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, errors.New(opts.localizer.MustLocalize("kafka.topic.produce.error.noValue"))
+	}
+
+	return values, nil
+}
@@ -4,10 +4,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/copy"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/create"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/delete"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/describe"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/messages"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/mirror"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/produce"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/topic/update"
 )
 
@@ -30,6 +34,10 @@ func NewTopicCommand(f *factory.Factory) *cobra.Command {
 		delete.NewDeleteTopicCommand(f),
 		describe.NewDescribeTopicCommand(f),
 		update.NewUpdateTopicCommand(f),
+		copy.NewCopyTopicCommand(f),
+		mirror.NewMirrorTopicCommand(f),
+		messages.NewMessagesTopicCommand(f),
+		produce.NewProduceTopicCommand(f),
 	)
 
 	return cmd
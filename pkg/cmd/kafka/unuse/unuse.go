@@ -0,0 +1,87 @@
+package unuse
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	IO        *iostreams.IOStreams
+	Config    config.IConfig
+	Logger    func() (logging.Logger, error)
+	localizer localize.Localizer
+}
+
+// NewUnuseCommand creates a command to clear the current Kafka instance
+func NewUnuseCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Config:    f.Config,
+		Logger:    f.Logger,
+		IO:        f.IOStreams,
+		localizer: f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("kafka.unuse.cmd.use"),
+		Short:   opts.localizer.MustLocalize("kafka.unuse.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("kafka.unuse.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("kafka.unuse.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUnuse(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runUnuse(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	// in a project directory the current Kafka instance is the one pinned in the project context file,
+	// which the user configuration cannot clear
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	local, path, err := config.LoadLocal(wd)
+	if err != nil {
+		return err
+	}
+	if local.HasKafka() {
+		return errors.New(opts.localizer.MustLocalize("kafka.unuse.error.setInLocalConfig", localize.NewEntry("Path", path)))
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	provider := kafka.ServiceProvider{}
+	id := provider.CurrentID(cfg)
+	if id == "" {
+		logger.Info(opts.localizer.MustLocalize("kafka.unuse.log.info.noKafkaSelected"))
+		return nil
+	}
+
+	provider.Unuse(cfg)
+	if err = opts.Config.Save(cfg); err != nil {
+		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("kafka.unuse.error.saveError"), err)
+	}
+
+	logger.Info(opts.localizer.MustLocalize("kafka.unuse.log.info.unuseSuccess", localize.NewEntry("ID", id)))
+
+	return nil
+}
@@ -119,13 +119,8 @@ func runUse(opts *Options) error {
 		}
 	}
 
-	// build Kafka config object from the response
-	var kafkaConfig config.KafkaConfig = config.KafkaConfig{
-		ClusterID: res.GetId(),
-	}
-
 	nameTmplEntry := localize.NewEntry("Name", res.GetName())
-	cfg.Services.Kafka = &kafkaConfig
+	kafka.ServiceProvider{}.Use(cfg, res.GetId())
 	if err := opts.Config.Save(cfg); err != nil {
 		saveErrMsg := opts.localizer.MustLocalize("kafka.use.error.saveError", nameTmplEntry)
 		return fmt.Errorf("%v: %w", saveErrMsg, err)
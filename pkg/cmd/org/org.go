@@ -0,0 +1,27 @@
+// Package org contains commands for viewing the organization of the user and managing the roles of its members
+package org
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/rolebinding"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/roles"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/show"
+	"github.com/spf13/cobra"
+)
+
+func NewOrgCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("org.cmd.use"),
+		Short: f.Localizer.MustLocalize("org.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("org.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		show.NewShowCommand(f),
+		rolebinding.NewRoleBindingCommand(f),
+		roles.NewRolesCommand(f),
+	)
+
+	return cmd
+}
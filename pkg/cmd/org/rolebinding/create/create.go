@@ -0,0 +1,130 @@
+package create
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+type Options struct {
+	outputFormat string
+	username     string
+	role         string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewCreateCommand creates a command to bind a role to an account of the organization
+func NewCreateCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("org.roleBinding.create.cmd.use"),
+		Short:   opts.localizer.MustLocalize("org.roleBinding.create.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("org.roleBinding.create.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("org.roleBinding.create.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.username, "username", "", opts.localizer.MustLocalize("org.roleBinding.create.flag.username.description"))
+	cmd.Flags().StringVar(&opts.role, "role", "", opts.localizer.MustLocalize("org.roleBinding.create.flag.role.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("org.roleBinding.create.flag.output.description"))
+
+	_ = cmd.MarkFlagRequired("username")
+	_ = cmd.MarkFlagRequired("role")
+
+	_ = cmd.RegisterFlagCompletionFunc("role", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FetchOrgRoles(f, toComplete)
+	})
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runCreate(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	account, err := accountmgmtutil.GetAccountByUsername(ctx, api, orgID, opts.username)
+	if err != nil {
+		return err
+	}
+
+	tmplEntries := []*localize.TemplateEntry{
+		localize.NewEntry("Role", opts.role),
+		localize.NewEntry("Username", opts.username),
+	}
+
+	existing, err := accountmgmtutil.ListRoleBindings(ctx, api, orgID, accountmgmtutil.RoleBindingFilter{
+		Username: opts.username,
+		RoleID:   opts.role,
+	})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		logger.Info(opts.localizer.MustLocalize("org.roleBinding.create.log.info.alreadyBound", tmplEntries...))
+		return nil
+	}
+
+	binding, err := accountmgmtutil.CreateRoleBinding(ctx, api, orgID, account.GetId(), opts.role)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("org.roleBinding.create.log.info.createSuccess", tmplEntries...))
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(binding)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(binding)
+		_ = dump.YAML(opts.IO.Out, data)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+)
+
+// deleteRow contains the properties used to
+// display a role binding which will be deleted
+type deleteRow struct {
+	ID       string `header:"ID"`
+	Username string `header:"Username"`
+	Role     string `header:"Role"`
+}
+
+type Options struct {
+	id       string
+	username string
+	role     string
+	force    bool
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewDeleteCommand creates a command to remove roles from accounts of the organization
+func NewDeleteCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("org.roleBinding.delete.cmd.use"),
+		Short:   opts.localizer.MustLocalize("org.roleBinding.delete.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("org.roleBinding.delete.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("org.roleBinding.delete.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.id == "" && opts.username == "" && opts.role == "" {
+				return errors.New(opts.localizer.MustLocalize("org.roleBinding.delete.error.selectorRequired"))
+			}
+
+			if !opts.IO.CanPrompt() && !opts.force {
+				return flag.RequiredWhenNonInteractiveError("yes")
+			}
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("org.roleBinding.delete.flag.id.description"))
+	cmd.Flags().StringVar(&opts.username, "username", "", opts.localizer.MustLocalize("org.roleBinding.delete.flag.username.description"))
+	cmd.Flags().StringVar(&opts.role, "role", "", opts.localizer.MustLocalize("org.roleBinding.delete.flag.role.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("org.roleBinding.delete.flag.yes.description"))
+
+	_ = cmd.RegisterFlagCompletionFunc("role", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FetchOrgRoles(f, toComplete)
+	})
+
+	return cmd
+}
+
+func runDelete(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	var bindings []accountmgmtutil.RoleBinding
+	if opts.id != "" {
+		bindings, err = getByID(ctx, opts, api)
+	} else {
+		bindings, err = accountmgmtutil.ListRoleBindings(ctx, api, orgID, accountmgmtutil.RoleBindingFilter{
+			Username: opts.username,
+			RoleID:   opts.role,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 {
+		logger.Info(opts.localizer.MustLocalize("org.roleBinding.delete.log.info.noneFound"))
+		return nil
+	}
+
+	// the affected users are always shown, so that it is clear who loses which role
+	dump.Table(opts.IO.Out, mapRoleBindingsToRows(bindings))
+	logger.Info("")
+
+	countTmplEntry := localize.NewEntry("Count", len(bindings))
+	if !opts.force {
+		var confirmDelete bool
+		promptConfirmDelete := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("org.roleBinding.delete.input.confirmDelete.message", countTmplEntry),
+		}
+
+		if err = survey.AskOne(promptConfirmDelete, &confirmDelete); err != nil {
+			return err
+		}
+
+		if !confirmDelete {
+			logger.Debug(opts.localizer.MustLocalize("org.roleBinding.delete.log.debug.deleteNotConfirmed"))
+			return nil
+		}
+	}
+
+	ids := []string{}
+	for _, b := range bindings {
+		ids = append(ids, b.ID)
+	}
+
+	results := bulk.Run(ids, bulk.DefaultConcurrency, func(id string) error {
+		_, err := api.ApiAccountsMgmtV1RoleBindingsIdDelete(ctx, id).Execute()
+		return err
+	})
+
+	if failed := bulk.Failed(results); failed > 0 {
+		bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.deleted"))
+		return errors.New(opts.localizer.MustLocalize("org.roleBinding.delete.error.someFailed", localize.NewEntry("Count", failed)))
+	}
+
+	logger.Info(opts.localizer.MustLocalize("org.roleBinding.delete.log.info.deleteSuccess", countTmplEntry))
+
+	return nil
+}
+
+// getByID returns the role binding with the ID given by --id,
+// if it also matches the account and role given by --username and --role
+func getByID(ctx context.Context, opts *Options, api amsclient.DefaultApi) ([]accountmgmtutil.RoleBinding, error) {
+	binding, httpRes, err := accountmgmtutil.GetRoleBinding(ctx, api, opts.id)
+	if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if (opts.username != "" && binding.Username != opts.username) || (opts.role != "" && binding.RoleID != opts.role) {
+		return nil, nil
+	}
+
+	return []accountmgmtutil.RoleBinding{*binding}, nil
+}
+
+func mapRoleBindingsToRows(bindings []accountmgmtutil.RoleBinding) []deleteRow {
+	rows := []deleteRow{}
+
+	for _, b := range bindings {
+		rows = append(rows, deleteRow{
+			ID:       b.ID,
+			Username: b.Username,
+			Role:     b.RoleID,
+		})
+	}
+
+	return rows
+}
@@ -0,0 +1,137 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// roleBindingRow is the details of a role binding needed to print to a table
+type roleBindingRow struct {
+	ID       string `header:"ID"`
+	Username string `header:"Username"`
+	Role     string `header:"Role"`
+	Type     string `header:"Type"`
+}
+
+type Options struct {
+	outputFormat string
+	username     string
+	role         string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a command to list the role bindings of the organization
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("org.roleBinding.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("org.roleBinding.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("org.roleBinding.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("org.roleBinding.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.username, "username", "", opts.localizer.MustLocalize("org.roleBinding.list.flag.username.description"))
+	cmd.Flags().StringVar(&opts.role, "role", "", opts.localizer.MustLocalize("org.roleBinding.list.flag.role.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("org.roleBinding.list.flag.output.description"))
+
+	_ = cmd.RegisterFlagCompletionFunc("role", func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FetchOrgRoles(f, toComplete)
+	})
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	bindings, err := accountmgmtutil.ListRoleBindings(ctx, api, orgID, accountmgmtutil.RoleBindingFilter{
+		Username: opts.username,
+		RoleID:   opts.role,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("org.roleBinding.list.log.info.noRoleBindings"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(bindings)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(bindings)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, mapRoleBindingsToRows(bindings))
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapRoleBindingsToRows(bindings []accountmgmtutil.RoleBinding) []roleBindingRow {
+	rows := []roleBindingRow{}
+
+	for _, b := range bindings {
+		rows = append(rows, roleBindingRow{
+			ID:       b.ID,
+			Username: b.Username,
+			Role:     b.RoleID,
+			Type:     b.Type,
+		})
+	}
+
+	return rows
+}
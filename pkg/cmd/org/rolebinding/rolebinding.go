@@ -0,0 +1,27 @@
+// Package rolebinding contains commands for managing the roles bound to the accounts of the organization
+package rolebinding
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/rolebinding/create"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/rolebinding/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/rolebinding/list"
+	"github.com/spf13/cobra"
+)
+
+func NewRoleBindingCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("org.roleBinding.cmd.use"),
+		Short: f.Localizer.MustLocalize("org.roleBinding.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("org.roleBinding.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+		create.NewCreateCommand(f),
+		delete.NewDeleteCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,132 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// role is a role which can be bound to accounts, and what it permits
+type role struct {
+	ID          string       `json:"id" yaml:"id"`
+	Name        string       `json:"name" yaml:"name"`
+	Permissions []permission `json:"permissions" yaml:"permissions"`
+}
+
+type permission struct {
+	Resource string `json:"resource" yaml:"resource"`
+	Action   string `json:"action" yaml:"action"`
+}
+
+// roleRow is the details of a role needed to print to a table
+type roleRow struct {
+	ID          string `header:"ID"`
+	Name        string `header:"Name"`
+	Permissions int    `header:"Permissions"`
+}
+
+type Options struct {
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a command to list the roles which can be bound to accounts
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("org.roles.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("org.roles.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("org.roles.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("org.roles.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("org.roles.list.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := conn.API().AccountMgmt().ApiAccountsMgmtV1RolesGet(context.Background()).Size(100).Execute()
+	if err != nil {
+		return err
+	}
+
+	roles := mapRoles(res.GetItems())
+	if len(roles) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("org.roles.list.log.info.noRoles"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(roles)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(roles)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		rows := []roleRow{}
+		for _, r := range roles {
+			rows = append(rows, roleRow{ID: r.ID, Name: r.Name, Permissions: len(r.Permissions)})
+		}
+		dump.Table(opts.IO.Out, rows)
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapRoles(items []amsclient.Role) []role {
+	roles := []role{}
+
+	for _, r := range items {
+		rl := role{ID: r.GetId(), Name: r.GetName(), Permissions: []permission{}}
+		for _, p := range r.GetPermissions() {
+			rl.Permissions = append(rl.Permissions, permission{Resource: p.GetResource(), Action: p.GetAction()})
+		}
+		roles = append(roles, rl)
+	}
+
+	return roles
+}
@@ -0,0 +1,23 @@
+// Package roles contains commands for viewing the roles which can be bound to the accounts of the organization
+package roles
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org/roles/list"
+	"github.com/spf13/cobra"
+)
+
+func NewRolesCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("org.roles.cmd.use"),
+		Short: f.Localizer.MustLocalize("org.roles.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("org.roles.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		list.NewListCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,192 @@
+package show
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// orgDetails is the organization of the current account and its summary dashboard
+type orgDetails struct {
+	ID           string        `json:"id" yaml:"id"`
+	Name         string        `json:"name" yaml:"name"`
+	ExternalID   string        `json:"external_id,omitempty" yaml:"external_id,omitempty"`
+	EbsAccountID string        `json:"ebs_account_id,omitempty" yaml:"ebs_account_id,omitempty"`
+	CreatedAt    *time.Time    `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	Dashboard    []metricValue `json:"dashboard" yaml:"dashboard"`
+}
+
+// metricValue is the latest value of a metric of the summary dashboard
+type metricValue struct {
+	Name  string     `json:"name" yaml:"name"`
+	Value float64    `json:"value" yaml:"value"`
+	Time  *time.Time `json:"time,omitempty" yaml:"time,omitempty"`
+}
+
+// field is a value of the organization printed to a table
+type field struct {
+	Name  string `header:"Name"`
+	Value string `header:"Value"`
+}
+
+// metricRow is a metric of the summary dashboard printed to a table
+type metricRow struct {
+	Name  string `header:"Metric"`
+	Value string `header:"Value"`
+	Time  string `header:"Updated"`
+}
+
+type Options struct {
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewShowCommand creates a command to view the organization of the current account
+func NewShowCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("org.show.cmd.use"),
+		Short:   opts.localizer.MustLocalize("org.show.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("org.show.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("org.show.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runShow(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("org.show.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runShow(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	org, _, err := api.ApiAccountsMgmtV1OrganizationsIdGet(ctx, orgID).Execute()
+	if err != nil {
+		return err
+	}
+
+	details := orgDetails{
+		ID:           org.GetId(),
+		Name:         org.GetName(),
+		ExternalID:   org.GetExternalId(),
+		EbsAccountID: org.GetEbsAccountId(),
+		CreatedAt:    org.CreatedAt,
+		Dashboard:    []metricValue{},
+	}
+
+	// the dashboard is only visible to some users, so the organization is shown without it otherwise
+	summary, _, err := api.ApiAccountsMgmtV1OrganizationsIdSummaryDashboardGet(ctx, orgID).Execute()
+	if err != nil {
+		logger.Debug(opts.localizer.MustLocalize("org.show.log.debug.dashboardNotAvailable", localize.NewEntry("Error", err)))
+	} else {
+		details.Dashboard = latestMetricValues(summary)
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(details)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(details)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		printDetails(opts, details)
+	}
+
+	return nil
+}
+
+// latestMetricValues returns the latest value of each metric of the summary dashboard
+func latestMetricValues(summary amsclient.Summary) []metricValue {
+	values := []metricValue{}
+	for _, m := range summary.GetMetrics() {
+		value := metricValue{Name: m.GetName()}
+		for _, v := range m.GetVector() {
+			t := v.GetTime()
+			if value.Time == nil || t.After(*value.Time) {
+				value.Time = &t
+				value.Value = v.GetValue()
+			}
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func printDetails(opts *Options, d orgDetails) {
+	fields := []field{
+		{Name: opts.localizer.MustLocalize("org.show.field.id"), Value: d.ID},
+		{Name: opts.localizer.MustLocalize("org.show.field.name"), Value: d.Name},
+		{Name: opts.localizer.MustLocalize("org.show.field.externalID"), Value: d.ExternalID},
+		{Name: opts.localizer.MustLocalize("org.show.field.ebsAccountID"), Value: d.EbsAccountID},
+	}
+	if d.CreatedAt != nil {
+		fields = append(fields, field{Name: opts.localizer.MustLocalize("org.show.field.created"), Value: d.CreatedAt.Format(time.RFC3339)})
+	}
+	dump.Table(opts.IO.Out, fields)
+
+	if len(d.Dashboard) == 0 {
+		return
+	}
+
+	fmt.Fprintln(opts.IO.Out)
+	rows := []metricRow{}
+	for _, m := range d.Dashboard {
+		row := metricRow{Name: m.Name, Value: strconv.FormatFloat(m.Value, 'f', -1, 64)}
+		if m.Time != nil {
+			row.Time = m.Time.Format(time.RFC3339)
+		}
+		rows = append(rows, row)
+	}
+	dump.Table(opts.IO.Out, rows)
+}
@@ -0,0 +1,94 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+)
+
+func newAccountMgmtMock() *amsclient.DefaultApiMock {
+	orgID, orgName, externalID := "org-1", "Example Inc.", "12345"
+	metricName := "clusters_total"
+	older, newer := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 6, 2, 0, 0, 0, 0, time.UTC)
+	oldValue, newValue := 3.0, 4.0
+
+	mock := testutil.NewAccountMgmtMock(amsclient.Account{Organization: &amsclient.Organization{Id: &orgID}})
+	mock.ApiAccountsMgmtV1OrganizationsIdGetFunc = func(ctx context.Context, id string) amsclient.ApiApiAccountsMgmtV1OrganizationsIdGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsIdGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsIdGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsIdGetRequest) (amsclient.Organization, *http.Response, error) {
+		return amsclient.Organization{Id: &orgID, Name: &orgName, ExternalId: &externalID}, nil, nil
+	}
+	mock.ApiAccountsMgmtV1OrganizationsIdSummaryDashboardGetFunc = func(ctx context.Context, id string) amsclient.ApiApiAccountsMgmtV1OrganizationsIdSummaryDashboardGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsIdSummaryDashboardGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsIdSummaryDashboardGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsIdSummaryDashboardGetRequest) (amsclient.Summary, *http.Response, error) {
+		return amsclient.Summary{Metrics: []amsclient.SummaryMetrics{
+			{Name: &metricName, Vector: &[]amsclient.SummaryVector{
+				{Time: &newer, Value: &newValue},
+				{Time: &older, Value: &oldValue},
+			}},
+		}}, nil, nil
+	}
+	return mock
+}
+
+func TestShowCommand(t *testing.T) {
+	mock := newAccountMgmtMock()
+
+	tests := []struct {
+		name   string
+		args   []string
+		verify func(t *testing.T, out string)
+	}{
+		{
+			name: "table",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"Example Inc.", "12345", "clusters_total", "2021-06-02T00:00:00Z"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("output does not contain %q:\n%v", want, out)
+					}
+				}
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			verify: func(t *testing.T, out string) {
+				var details orgDetails
+				if err := json.Unmarshal([]byte(out), &details); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				if details.ID != "org-1" || details.Name != "Example Inc." || details.ExternalID != "12345" {
+					t.Errorf("organization = %+v", details)
+				}
+				if len(details.Dashboard) != 1 || details.Dashboard[0].Value != 4 {
+					t.Errorf("dashboard = %+v, want the latest value of each metric", details.Dashboard)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			f := testutil.NewAccountMgmtFactory(mock, &out)
+
+			cmd := NewShowCommand(f)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.verify(t, out.String())
+		})
+	}
+}
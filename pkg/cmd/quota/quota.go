@@ -0,0 +1,183 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// kafkaQuota is the number of Kafka instances the organization is allowed to create,
+// and its quotas, which are counted in quota units
+type kafkaQuota struct {
+	Allowed   int                     `json:"allowed" yaml:"allowed"`
+	Used      int                     `json:"used" yaml:"used"`
+	Remaining int                     `json:"remaining" yaml:"remaining"`
+	Quotas    []accountmgmtutil.Quota `json:"quotas" yaml:"quotas"`
+	SKUs      []accountmgmtutil.SKU   `json:"skus" yaml:"skus"`
+}
+
+// quotaStatus is the quota of each service of the organization
+type quotaStatus struct {
+	Kafka kafkaQuota `json:"kafka" yaml:"kafka"`
+}
+
+// quotaRow is a quota printed to a table, counted in instances
+type quotaRow struct {
+	ID        string `header:"Quota"`
+	Product   string `header:"Product"`
+	Allowed   int    `header:"Allowed Instances"`
+	Used      int    `header:"Used Instances"`
+	Remaining int    `header:"Remaining Instances"`
+}
+
+// skuRow is a SKU printed to a table
+type skuRow struct {
+	SKU                  string `header:"SKU"`
+	Count                int    `header:"Count"`
+	Allowed              int    `header:"Allowed"`
+	Type                 string `header:"Type"`
+	AvailabilityZoneType string `header:"Availability Zones"`
+}
+
+type Options struct {
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewQuotaCommand creates a command to view the quota of the organization of the user
+func NewQuotaCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("quota.cmd.use"),
+		Short:   opts.localizer.MustLocalize("quota.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("quota.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("quota.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runQuota(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("quota.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runQuota(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	quotas, err := accountmgmtutil.GetKafkaQuotas(ctx, api, orgID)
+	if err != nil {
+		return err
+	}
+
+	skus, err := accountmgmtutil.GetKafkaSKUs(ctx, api, orgID)
+	if err != nil {
+		return err
+	}
+
+	status := quotaStatus{Kafka: newKafkaQuota(quotas, skus)}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(status)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(status)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		if len(quotas) == 0 {
+			logger.Info(opts.localizer.MustLocalize("quota.log.info.noKafkaQuota"))
+			return nil
+		}
+		printKafkaQuota(opts, status.Kafka)
+	}
+
+	return nil
+}
+
+func newKafkaQuota(quotas []accountmgmtutil.Quota, skus []accountmgmtutil.SKU) kafkaQuota {
+	q := kafkaQuota{Quotas: quotas, SKUs: skus}
+	for _, quota := range quotas {
+		q.Allowed += quota.Instances(quota.Allowed)
+		q.Used += quota.Instances(quota.Consumed)
+		q.Remaining += quota.Instances(quota.Remaining())
+	}
+	return q
+}
+
+func printKafkaQuota(opts *Options, q kafkaQuota) {
+	fmt.Fprintln(opts.IO.Out, opts.localizer.MustLocalize("quota.kafka.summary",
+		localize.NewEntry("Allowed", q.Allowed),
+		localize.NewEntry("Used", q.Used),
+		localize.NewEntry("Remaining", q.Remaining),
+	))
+	fmt.Fprintln(opts.IO.Out)
+
+	quotaRows := []quotaRow{}
+	for _, quota := range q.Quotas {
+		quotaRows = append(quotaRows, quotaRow{
+			ID:        quota.ID,
+			Product:   quota.Product,
+			Allowed:   quota.Instances(quota.Allowed),
+			Used:      quota.Instances(quota.Consumed),
+			Remaining: quota.Instances(quota.Remaining()),
+		})
+	}
+	dump.Table(opts.IO.Out, quotaRows)
+
+	if len(q.SKUs) == 0 {
+		return
+	}
+
+	fmt.Fprintln(opts.IO.Out)
+	skuRows := []skuRow{}
+	for _, sku := range q.SKUs {
+		skuRows = append(skuRows, skuRow(sku))
+	}
+	dump.Table(opts.IO.Out, skuRows)
+}
@@ -0,0 +1,105 @@
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+)
+
+func newAccountMgmtMock() *amsclient.DefaultApiMock {
+	orgID := "org-1"
+	resourceName := "rhosak"
+	sku := "MW00530"
+	skuCount := int32(2)
+	zones := "multi"
+
+	mock := testutil.NewAccountMgmtMock(amsclient.Account{Organization: &amsclient.Organization{Id: &orgID}})
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetFunc = func(ctx context.Context, orgID string) amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdQuotaCostGetRequest) (amsclient.QuotaCostList, *http.Response, error) {
+		return amsclient.QuotaCostList{Items: []amsclient.QuotaCost{
+			{
+				QuotaId:  "cluster|rhinfra|rhosak|marketplace",
+				Allowed:  10,
+				Consumed: 4,
+				// each instance consumes two units of quota
+				RelatedResources: &[]amsclient.RelatedResource{
+					{ResourceName: &resourceName, Product: "RHOSAK", Cost: 2},
+				},
+			},
+		}}, nil, nil
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetFunc = func(ctx context.Context, orgID string) amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1OrganizationsOrgIdResourceQuotaGetRequest) (amsclient.ResourceQuotaList, *http.Response, error) {
+		return amsclient.ResourceQuotaList{Items: []amsclient.ResourceQuota{
+			{ResourceName: "rhosak", Allowed: 5, Sku: &sku, SkuCount: &skuCount, AvailabilityZoneType: &zones},
+			{ResourceName: "compute.node.aws", Allowed: 10},
+		}}, nil, nil
+	}
+	return mock
+}
+
+func TestQuotaCommand(t *testing.T) {
+	mock := newAccountMgmtMock()
+
+	tests := []struct {
+		name   string
+		args   []string
+		verify func(t *testing.T, out string)
+	}{
+		{
+			name: "table",
+			verify: func(t *testing.T, out string) {
+				for _, want := range []string{"5 allowed, 2 used, 3 remaining", "cluster|rhinfra|rhosak|marketplace", "MW00530"} {
+					if !strings.Contains(out, want) {
+						t.Errorf("output does not contain %q:\n%v", want, out)
+					}
+				}
+			},
+		},
+		{
+			name: "json",
+			args: []string{"-o", "json"},
+			verify: func(t *testing.T, out string) {
+				var status quotaStatus
+				if err := json.Unmarshal([]byte(out), &status); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				if status.Kafka.Allowed != 5 || status.Kafka.Used != 2 || status.Kafka.Remaining != 3 {
+					t.Errorf("Kafka quota = %+v", status.Kafka)
+				}
+				if q := status.Kafka.Quotas[0]; q.Allowed != 10 || q.Consumed != 4 || q.Cost != 2 {
+					t.Errorf("quota = %+v, want quota units", q)
+				}
+				if len(status.Kafka.SKUs) != 1 || status.Kafka.SKUs[0].SKU != "MW00530" || status.Kafka.SKUs[0].Count != 2 {
+					t.Errorf("SKUs = %+v", status.Kafka.SKUs)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			f := testutil.NewAccountMgmtFactory(mock, &out)
+
+			cmd := NewQuotaCommand(f)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.verify(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package artifact
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/create"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/get"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact/update"
+	"github.com/spf13/cobra"
+)
+
+// NewArtifactCommand gives commands that manage the artifacts of a Service Registry instance
+func NewArtifactCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("registry.artifact.cmd.use"),
+		Short: f.Localizer.MustLocalize("registry.artifact.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("registry.artifact.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		create.NewCreateCommand(f),
+		get.NewGetCommand(f),
+		list.NewListCommand(f),
+		update.NewUpdateCommand(f),
+		delete.NewDeleteCommand(f),
+	)
+
+	return cmd
+}
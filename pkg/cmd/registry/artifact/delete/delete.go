@@ -0,0 +1,139 @@
+package delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	instanceID string
+	group      string
+	artifactID string
+	force      bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewDeleteCommand creates a new command for deleting artifacts.
+func NewDeleteCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.artifact.delete.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.artifact.delete.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.artifact.delete.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.artifact.delete.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.artifactID = args[0]
+
+			if !opts.IO.CanPrompt() && !opts.force {
+				return flag.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if opts.instanceID != "" {
+				return runDelete(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.noRegistrySelected"))
+			}
+
+			opts.instanceID = cfg.Services.Registry.InstanceID
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", artifact.DefaultGroup, opts.localizer.MustLocalize("registry.artifact.common.flag.group.description"))
+	cmd.Flags().StringVar(&opts.instanceID, "instance-id", "", opts.localizer.MustLocalize("registry.artifact.common.flag.instanceID.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("registry.artifact.delete.flag.yes.description"))
+
+	return cmd
+}
+
+func runDelete(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	client, registry, err := conn.API().ServiceRegistryArtifact(opts.instanceID)
+	if err != nil {
+		return err
+	}
+
+	notFoundErr := errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.artifactNotFound",
+		localize.NewEntry("ArtifactID", opts.artifactID),
+		localize.NewEntry("Group", opts.group),
+		localize.NewEntry("InstanceName", registry.GetName()),
+	))
+
+	ctx := context.Background()
+
+	// check that the artifact exists before asking for confirmation
+	if _, err = client.GetMetadata(ctx, opts.group, opts.artifactID); artifact.IsNotFound(err) {
+		return notFoundErr
+	} else if err != nil {
+		return err
+	}
+
+	if !opts.force {
+		var confirmed bool
+		promptConfirm := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("registry.artifact.delete.input.confirm.message", localize.NewEntry("ArtifactID", opts.artifactID)),
+		}
+		if err = survey.AskOne(promptConfirm, &confirmed); err != nil {
+			return err
+		}
+
+		if !confirmed {
+			logger.Debug(opts.localizer.MustLocalize("registry.artifact.delete.log.debug.deleteNotConfirmed"))
+			return nil
+		}
+	}
+
+	if err = client.Delete(ctx, opts.group, opts.artifactID); artifact.IsNotFound(err) {
+		return notFoundErr
+	} else if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.artifact.delete.log.info.deleteSuccess",
+		localize.NewEntry("ArtifactID", opts.artifactID),
+		localize.NewEntry("InstanceName", registry.GetName()),
+	))
+
+	return nil
+}
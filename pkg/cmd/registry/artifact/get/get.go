@@ -0,0 +1,119 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	instanceID string
+	group      string
+	artifactID string
+	outputFile string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewGetCommand creates a new command for getting the content of an artifact.
+func NewGetCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.artifact.get.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.artifact.get.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.artifact.get.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.artifact.get.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.artifactID = args[0]
+
+			if opts.instanceID != "" {
+				return runGet(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.noRegistrySelected"))
+			}
+
+			opts.instanceID = cfg.Services.Registry.InstanceID
+
+			return runGet(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", artifact.DefaultGroup, opts.localizer.MustLocalize("registry.artifact.common.flag.group.description"))
+	cmd.Flags().StringVar(&opts.instanceID, "instance-id", "", opts.localizer.MustLocalize("registry.artifact.common.flag.instanceID.description"))
+	cmd.Flags().StringVar(&opts.outputFile, "output-file", "", opts.localizer.MustLocalize("registry.artifact.get.flag.outputFile.description"))
+
+	return cmd
+}
+
+func runGet(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	client, registry, err := conn.API().ServiceRegistryArtifact(opts.instanceID)
+	if err != nil {
+		return err
+	}
+
+	content, err := client.Get(context.Background(), opts.group, opts.artifactID)
+	if artifact.IsNotFound(err) {
+		return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.artifactNotFound",
+			localize.NewEntry("ArtifactID", opts.artifactID),
+			localize.NewEntry("Group", opts.group),
+			localize.NewEntry("InstanceName", registry.GetName()),
+		))
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.outputFile == "" {
+		_, err = opts.IO.Out.Write(content)
+		return err
+	}
+
+	if err = ioutil.WriteFile(opts.outputFile, content, 0600); err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.artifact.get.log.info.savedToFile",
+		localize.NewEntry("ArtifactID", opts.artifactID),
+		localize.NewEntry("File", opts.outputFile),
+	))
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// artifactRow is the details of an artifact needed to print to a table
+type artifactRow struct {
+	ID         string `json:"id" header:"ID"`
+	Name       string `json:"name" header:"Name"`
+	Group      string `json:"groupId" header:"Group"`
+	Type       string `json:"type" header:"Type"`
+	State      string `json:"state" header:"State"`
+	ModifiedOn string `json:"modifiedOn" header:"Modified On"`
+}
+
+type options struct {
+	instanceID   string
+	group        string
+	page         int
+	limit        int
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a new command for listing artifacts.
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.artifact.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.artifact.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.artifact.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.artifact.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			if opts.page < 1 {
+				return flag.InvalidValueError("page", opts.page)
+			}
+
+			if opts.instanceID != "" {
+				return runList(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.noRegistrySelected"))
+			}
+
+			opts.instanceID = cfg.Services.Registry.InstanceID
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", opts.localizer.MustLocalize("registry.artifact.list.flag.group.description"))
+	cmd.Flags().StringVar(&opts.instanceID, "instance-id", "", opts.localizer.MustLocalize("registry.artifact.common.flag.instanceID.description"))
+	cmd.Flags().IntVar(&opts.page, "page", 1, opts.localizer.MustLocalize("registry.artifact.list.flag.page"))
+	cmd.Flags().IntVar(&opts.limit, "limit", 100, opts.localizer.MustLocalize("registry.artifact.list.flag.limit"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("registry.artifact.list.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	client, registry, err := conn.API().ServiceRegistryArtifact(opts.instanceID)
+	if err != nil {
+		return err
+	}
+
+	list, err := client.List(context.Background(), opts.group, (opts.page-1)*opts.limit, opts.limit)
+	if err != nil {
+		return err
+	}
+
+	if len(list.Artifacts) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("registry.artifact.list.log.info.noArtifacts", localize.NewEntry("InstanceName", registry.GetName())))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(list)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(list)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, mapArtifactsToRows(list.Artifacts))
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapArtifactsToRows(artifacts []artifact.Metadata) []artifactRow {
+	rows := []artifactRow{}
+
+	for _, a := range artifacts {
+		group := a.GroupID
+		if group == "" {
+			group = artifact.DefaultGroup
+		}
+
+		rows = append(rows, artifactRow{
+			ID:         a.ID,
+			Name:       a.Name,
+			Group:      group,
+			Type:       a.Type,
+			State:      a.State,
+			ModifiedOn: a.ModifiedOn,
+		})
+	}
+
+	return rows
+}
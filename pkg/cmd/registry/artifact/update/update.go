@@ -0,0 +1,142 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+type Options struct {
+	instanceID   string
+	group        string
+	artifactID   string
+	file         string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewUpdateCommand creates a new command for updating the content of an artifact.
+func NewUpdateCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.artifact.update.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.artifact.update.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.artifact.update.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.artifact.update.cmd.example"),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.artifactID = args[0]
+
+			if opts.file == "" && opts.IO.IsStdinTTY() {
+				return flag.RequiredWhenNonInteractiveError("file")
+			}
+
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			if opts.instanceID != "" {
+				return runUpdate(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.noRegistrySelected"))
+			}
+
+			opts.instanceID = cfg.Services.Registry.InstanceID
+
+			return runUpdate(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", opts.localizer.MustLocalize("registry.artifact.common.flag.file.description"))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", artifact.DefaultGroup, opts.localizer.MustLocalize("registry.artifact.common.flag.group.description"))
+	cmd.Flags().StringVar(&opts.instanceID, "instance-id", "", opts.localizer.MustLocalize("registry.artifact.common.flag.instanceID.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("registry.artifact.common.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runUpdate(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	content, err := artifact.ReadContent(opts.file, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	client, registry, err := conn.API().ServiceRegistryArtifact(opts.instanceID)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := client.Update(context.Background(), opts.group, opts.artifactID, content)
+	if artifact.IsNotFound(err) {
+		return errors.New(opts.localizer.MustLocalize("registry.artifact.common.error.artifactNotFound",
+			localize.NewEntry("ArtifactID", opts.artifactID),
+			localize.NewEntry("Group", opts.group),
+			localize.NewEntry("InstanceName", registry.GetName()),
+		))
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.artifact.update.log.info.updateSuccess",
+		localize.NewEntry("ArtifactID", metadata.ID),
+		localize.NewEntry("Version", metadata.Version),
+	))
+
+	switch opts.outputFormat {
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(metadata)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		data, _ := json.MarshalIndent(metadata, "", cmdutil.DefaultJSONIndent)
+		_ = dump.JSON(opts.IO.Out, data)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+type Options struct {
+	name string
+
+	outputFormat string
+	autoUse      bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewCreateCommand creates a new command for creating Service Registry instances.
+func NewCreateCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.create.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.create.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.create.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.create.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = args[0]
+			} else if !opts.IO.CanPrompt() {
+				return errors.New(opts.localizer.MustLocalize("registry.create.argument.name.error.requiredWhenNonInteractive"))
+			} else {
+				promptName := &survey.Input{
+					Message: opts.localizer.MustLocalize("registry.create.input.name.message"),
+					Help:    opts.localizer.MustLocalize("registry.create.input.name.help"),
+				}
+				if err := survey.AskOne(promptName, &opts.name, survey.WithValidator(serviceregistry.ValidateName)); err != nil {
+					return err
+				}
+			}
+
+			if err := serviceregistry.ValidateName(opts.name); err != nil {
+				return err
+			}
+
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("registry.common.flag.output.description"))
+	cmd.Flags().BoolVar(&opts.autoUse, "use", true, opts.localizer.MustLocalize("registry.create.flag.autoUse.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runCreate(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.create.log.info.creatingRegistry", localize.NewEntry("Name", opts.name)))
+
+	payload := srsmgmtclient.RegistryCreate{
+		Name: &opts.name,
+	}
+
+	response, httpRes, err := conn.API().ServiceRegistryMgmt().
+		CreateRegistry(context.Background()).
+		RegistryCreate(payload).
+		Execute()
+	if httpRes != nil && httpRes.StatusCode == http.StatusConflict {
+		return errors.New(opts.localizer.MustLocalize("registry.create.error.conflictError", localize.NewEntry("Name", opts.name)))
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.create.info.successMessage", localize.NewEntry("Name", response.GetName())))
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.MarshalIndent(response, "", cmdutil.DefaultJSONIndent)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(response)
+		_ = dump.YAML(opts.IO.Out, data)
+	}
+
+	if !opts.autoUse {
+		logger.Debug(opts.localizer.MustLocalize("registry.create.debug.autoUseNotSetMessage"))
+		return nil
+	}
+
+	logger.Debug(opts.localizer.MustLocalize("registry.create.debug.autoUseSetMessage"))
+	serviceregistry.ServiceProvider{}.Use(cfg, serviceregistry.FormatID(response.GetId()))
+	if err := opts.Config.Save(cfg); err != nil {
+		return fmt.Errorf("%v: %w", opts.localizer.MustLocalize("registry.common.error.couldNotUseRegistry"), err)
+	}
+
+	return nil
+}
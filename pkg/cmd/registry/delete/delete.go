@@ -0,0 +1,152 @@
+package delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	id    string
+	name  string
+	force bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewDeleteCommand command for deleting Service Registry instances.
+func NewDeleteCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.delete.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.delete.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.delete.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.delete.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidServiceRegistries(f, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.IO.CanPrompt() && !opts.force {
+				return flag.RequiredWhenNonInteractiveError("yes")
+			}
+
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+
+			if opts.name != "" && opts.id != "" {
+				return errors.New(opts.localizer.MustLocalize("registry.common.error.idAndNameCannotBeUsed"))
+			}
+
+			if opts.id != "" || opts.name != "" {
+				return runDelete(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.common.error.noRegistrySelected"))
+			}
+
+			opts.id = cfg.Services.Registry.InstanceID
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.delete.flag.id"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("registry.delete.flag.yes"))
+
+	return cmd
+}
+
+func runDelete(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var registry *srsmgmtclient.Registry
+	ctx := context.Background()
+	if opts.name != "" {
+		registry, _, err = serviceregistry.GetRegistryByName(ctx, api.ServiceRegistryMgmt(), opts.name)
+	} else {
+		registry, _, err = serviceregistry.GetRegistryByID(ctx, api.ServiceRegistryMgmt(), opts.id)
+	}
+	if err != nil {
+		return err
+	}
+
+	registryName := registry.GetName()
+
+	if !opts.force {
+		promptConfirmName := &survey.Input{
+			Message: opts.localizer.MustLocalize("registry.delete.input.confirmName.message"),
+		}
+
+		var confirmedName string
+		if err = survey.AskOne(promptConfirmName, &confirmedName); err != nil {
+			return err
+		}
+
+		if confirmedName != registryName {
+			logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.incorrectNameConfirmation"))
+			return nil
+		}
+	}
+
+	logger.Debug(opts.localizer.MustLocalize("registry.delete.log.debug.deletingRegistry", localize.NewEntry("Name", registryName)))
+	if _, err = api.ServiceRegistryMgmt().DeleteRegistry(ctx, registry.GetId()).Execute(); err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.delete.log.info.deleteSuccess", localize.NewEntry("Name", registryName)))
+
+	// the deleted instance was the current instance, so it should be removed from the config
+	provider := serviceregistry.ServiceProvider{}
+	if provider.CurrentID(cfg) == serviceregistry.FormatID(registry.GetId()) {
+		provider.Unuse(cfg)
+		return opts.Config.Save(cfg)
+	}
+
+	return nil
+}
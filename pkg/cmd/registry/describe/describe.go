@@ -0,0 +1,127 @@
+package describe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+type Options struct {
+	id           string
+	name         string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	localizer  localize.Localizer
+}
+
+// NewDescribeCommand describes a Service Registry instance, either by passing an `--id flag`
+// or by using the instance set in the config, if any
+func NewDescribeCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.describe.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.describe.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.describe.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.describe.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidServiceRegistries(f, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+
+			if opts.name != "" && opts.id != "" {
+				return errors.New(opts.localizer.MustLocalize("registry.common.error.idAndNameCannotBeUsed"))
+			}
+
+			if opts.id != "" || opts.name != "" {
+				return runDescribe(opts)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasRegistry() {
+				return errors.New(opts.localizer.MustLocalize("registry.common.error.noRegistrySelected"))
+			}
+
+			opts.id = cfg.Services.Registry.InstanceID
+
+			return runDescribe(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("registry.common.flag.output.description"))
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.describe.flag.id"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runDescribe(opts *Options) error {
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var registry *srsmgmtclient.Registry
+	ctx := context.Background()
+	if opts.name != "" {
+		registry, _, err = serviceregistry.GetRegistryByName(ctx, api.ServiceRegistryMgmt(), opts.name)
+	} else {
+		registry, _, err = serviceregistry.GetRegistryByID(ctx, api.ServiceRegistryMgmt(), opts.id)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch opts.outputFormat {
+	case "yaml", "yml":
+		data, err := yaml.Marshal(registry)
+		if err != nil {
+			return err
+		}
+		return dump.YAML(opts.IO.Out, data)
+	default:
+		data, err := json.Marshal(registry)
+		if err != nil {
+			return err
+		}
+		return dump.JSON(opts.IO.Out, data)
+	}
+}
@@ -0,0 +1,138 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// registryRow is the details of a Service Registry instance needed to print to a table
+type registryRow struct {
+	ID          string `json:"id" header:"ID"`
+	Name        string `json:"name" header:"Name"`
+	Status      string `json:"status" header:"Status"`
+	RegistryURL string `json:"registryUrl" header:"Registry URL"`
+}
+
+type options struct {
+	outputFormat string
+	page         int
+	limit        int
+	search       string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a new command for listing Service Registry instances.
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("registry.list.flag.output.description"))
+	cmd.Flags().IntVar(&opts.page, "page", 1, opts.localizer.MustLocalize("registry.list.flag.page"))
+	cmd.Flags().IntVar(&opts.limit, "limit", 100, opts.localizer.MustLocalize("registry.list.flag.limit"))
+	cmd.Flags().StringVar(&opts.search, "search", "", opts.localizer.MustLocalize("registry.list.flag.search"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	a := conn.API().ServiceRegistryMgmt().GetRegistries(context.Background())
+	a = a.Page(strconv.Itoa(opts.page))
+	a = a.Size(strconv.Itoa(opts.limit))
+
+	if opts.search != "" {
+		query := "name like %" + opts.search + "%"
+		logger.Debug(opts.localizer.MustLocalize("registry.list.log.debug.filteringList", localize.NewEntry("Search", query)))
+		a = a.Search(query)
+	}
+
+	response, _, err := a.Execute()
+	if err != nil {
+		return err
+	}
+
+	if len(response.GetItems()) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("registry.common.log.info.noRegistryInstances"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(response)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(response)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		dump.Table(opts.IO.Out, mapResponseItemsToRows(response.GetItems()))
+		logger.Info("")
+	}
+
+	return nil
+}
+
+func mapResponseItemsToRows(registries []srsmgmtclient.Registry) []registryRow {
+	rows := []registryRow{}
+
+	for _, r := range registries {
+		rows = append(rows, registryRow{
+			ID:          serviceregistry.FormatID(r.GetId()),
+			Name:        r.GetName(),
+			Status:      string(r.GetStatus()),
+			RegistryURL: r.GetRegistryUrl(),
+		})
+	}
+
+	return rows
+}
@@ -0,0 +1,34 @@
+// Package registry contains commands for managing Service Registry instances and their artifacts
+package registry
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/artifact"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/create"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/delete"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/describe"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/list"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry/use"
+	"github.com/spf13/cobra"
+)
+
+func NewServiceRegistryCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("registry.cmd.use"),
+		Short: f.Localizer.MustLocalize("registry.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("registry.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	// add sub-commands
+	cmd.AddCommand(
+		create.NewCreateCommand(f),
+		describe.NewDescribeCommand(f),
+		delete.NewDeleteCommand(f),
+		list.NewListCommand(f),
+		use.NewUseCommand(f),
+		artifact.NewArtifactCommand(f),
+	)
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package use
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	id          string
+	name        string
+	interactive bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+func NewUseCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		IO:         f.IOStreams,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("registry.use.cmd.use"),
+		Short:   opts.localizer.MustLocalize("registry.use.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("registry.use.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("registry.use.cmd.example"),
+		Args:    cobra.RangeArgs(0, 1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return cmdutil.FilterValidServiceRegistries(f, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = args[0]
+			} else if opts.id == "" {
+				if !opts.IO.CanPrompt() {
+					return errors.New(opts.localizer.MustLocalize("registry.use.error.idOrNameRequired"))
+				}
+				opts.interactive = true
+			}
+
+			if opts.name != "" && opts.id != "" {
+				return errors.New(opts.localizer.MustLocalize("registry.common.error.idAndNameCannotBeUsed"))
+			}
+
+			return runUse(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("registry.use.flag.id"))
+
+	return cmd
+}
+
+func runUse(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config.Load()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	var registry *srsmgmtclient.Registry
+	ctx := context.Background()
+	switch {
+	case opts.interactive:
+		logger.Debug(opts.localizer.MustLocalize("common.log.debug.startingInteractivePrompt"))
+		registry, err = serviceregistry.InteractiveSelect(conn, logger)
+		if err == nil && registry == nil {
+			// no instance was selected, exit program
+			return nil
+		}
+	case opts.name != "":
+		registry, _, err = serviceregistry.GetRegistryByName(ctx, api.ServiceRegistryMgmt(), opts.name)
+	default:
+		registry, _, err = serviceregistry.GetRegistryByID(ctx, api.ServiceRegistryMgmt(), opts.id)
+	}
+	if err != nil {
+		return err
+	}
+
+	nameTmplEntry := localize.NewEntry("Name", registry.GetName())
+	serviceregistry.ServiceProvider{}.Use(cfg, serviceregistry.FormatID(registry.GetId()))
+	if err := opts.Config.Save(cfg); err != nil {
+		saveErrMsg := opts.localizer.MustLocalize("registry.use.error.saveError", nameTmplEntry)
+		return fmt.Errorf("%v: %w", saveErrMsg, err)
+	}
+
+	logger.Info(opts.localizer.MustLocalize("registry.use.log.info.useSuccess", nameTmplEntry))
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package root
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/spf13/cobra"
+)
+
+// offlineFeatureTogglesTTL is how long cached feature toggles are used when they cannot be queried
+const offlineFeatureTogglesTTL = 7 * 24 * time.Hour
+
+// featureToggles evaluates the feature toggles which enable the preview commands.
+// A preview command declares the ID of its toggle with the cmdutil.FeatureToggleAnnotation annotation,
+// and is hidden and disabled unless the toggle is enabled for the organization of the user.
+type featureToggles struct {
+	factory *factory.Factory
+	// enablePreview enables all the preview commands, whatever their toggles
+	enablePreview bool
+	// enabled holds the toggles evaluated so far by ID
+	enabled map[string]bool
+	// cached holds the last known toggles by ID, read from the cache when they are first needed
+	cached map[string]bool
+}
+
+// addPreviewGating hides the preview commands of cmd which are not enabled, and fails when one of them is run.
+// It must be called once all the subcommands of cmd are added.
+// Only the toggles in the cache are used to hide the commands, so that the help and the shell completion
+// do not make requests. The toggle of a preview command is queried when it is run.
+func addPreviewGating(cmd *cobra.Command, toggles *featureToggles) {
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		return toggles.checkEnabled(cmd)
+	}
+
+	// the commands are hidden before the arguments are parsed, so that the completion does not offer them
+	toggles.hideDisabled(cmd)
+
+	helpFunc := cmd.HelpFunc()
+	cmd.SetHelpFunc(func(c *cobra.Command, args []string) {
+		// the --enable-preview flag is parsed once the help is requested
+		toggles.hideDisabled(cmd)
+		helpFunc(c, args)
+	})
+}
+
+// toggleID returns the ID of the feature toggle of cmd, or of the closest of its parents which has one
+func toggleID(cmd *cobra.Command) (string, bool) {
+	for c := cmd; c != nil; c = c.Parent() {
+		if id, ok := c.Annotations[cmdutil.FeatureToggleAnnotation]; ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// checkEnabled returns an error if cmd is a preview command which is not enabled
+func (t *featureToggles) checkEnabled(cmd *cobra.Command) error {
+	id, ok := toggleID(cmd)
+	if !ok {
+		return nil
+	}
+
+	enabled, err := t.isEnabled(id)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		return nil
+	}
+
+	return errors.New(t.factory.Localizer.MustLocalize("root.error.previewNotEnabled", localize.NewEntry("Command", cmd.CommandPath())))
+}
+
+// hideDisabled hides the preview commands in the command tree of cmd which are not known to be enabled
+func (t *featureToggles) hideDisabled(cmd *cobra.Command) {
+	for _, c := range cmd.Commands() {
+		if id, ok := c.Annotations[cmdutil.FeatureToggleAnnotation]; ok {
+			c.Hidden = !t.isCachedEnabled(id)
+		}
+		t.hideDisabled(c)
+	}
+}
+
+// isCachedEnabled returns true if the feature toggle with the given ID is known to be enabled,
+// without querying it
+func (t *featureToggles) isCachedEnabled(id string) bool {
+	if t.enablePreview {
+		return true
+	}
+
+	if enabled, ok := t.enabled[id]; ok {
+		return enabled
+	}
+
+	return t.lastKnown()[id]
+}
+
+// lastKnown returns the toggles in the cache, as long as they are not older than offlineFeatureTogglesTTL
+func (t *featureToggles) lastKnown() map[string]bool {
+	if t.cached != nil {
+		return t.cached
+	}
+
+	t.cached = map[string]bool{}
+	_ = t.responseCache().Get(cache.FeatureTogglesKey, offlineFeatureTogglesTTL, &t.cached)
+	return t.cached
+}
+
+func (t *featureToggles) responseCache() *cache.Cache {
+	if t.factory.Cache == nil {
+		return nil
+	}
+	return t.factory.Cache()
+}
+
+// isEnabled returns true if the feature toggle with the given ID is enabled for the organization of the user.
+// The toggles are cached, and when they cannot be queried the last known toggles are used.
+// An error is returned when a toggle can neither be queried nor found in the cache.
+func (t *featureToggles) isEnabled(id string) (bool, error) {
+	if t.enablePreview {
+		return true, nil
+	}
+
+	if enabled, ok := t.enabled[id]; ok {
+		return enabled, nil
+	}
+	if t.enabled == nil {
+		t.enabled = map[string]bool{}
+	}
+
+	responseCache := t.responseCache()
+
+	toggles := map[string]bool{}
+	if responseCache.Get(cache.FeatureTogglesKey, cache.FeatureTogglesTTL, &toggles) {
+		if enabled, ok := toggles[id]; ok {
+			t.enabled[id] = enabled
+			return enabled, nil
+		}
+	}
+
+	enabled, err := t.query(id)
+	if err != nil {
+		lastKnown, ok := t.lastKnown()[id]
+		if !ok {
+			return false, err
+		}
+
+		if logger, loggerErr := t.factory.Logger(); loggerErr == nil {
+			logger.Debug(t.factory.Localizer.MustLocalize("root.log.debug.featureToggleNotQueried",
+				localize.NewEntry("ID", id),
+				localize.NewEntry("Error", err),
+			))
+		}
+
+		t.enabled[id] = lastKnown
+		return lastKnown, nil
+	}
+
+	toggles[id] = enabled
+	_ = responseCache.Set(cache.FeatureTogglesKey, toggles)
+
+	t.enabled[id] = enabled
+	return enabled, nil
+}
+
+// query queries the feature toggle with the given ID for the organization of the user
+func (t *featureToggles) query(id string) (bool, error) {
+	conn, err := t.factory.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return false, err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return false, err
+	}
+
+	return accountmgmtutil.IsFeatureToggleEnabled(ctx, api, id, orgID)
+}
@@ -0,0 +1,163 @@
+package root
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/testutil"
+	"github.com/spf13/cobra"
+)
+
+func newFeatureTogglesMock(toggles map[string]bool, queryErr error) *amsclient.DefaultApiMock {
+	orgID := "org-1"
+
+	mock := testutil.NewAccountMgmtMock(amsclient.Account{Organization: &amsclient.Organization{Id: &orgID}})
+	// the request does not expose the ID of the toggle, so it is kept for the next execution
+	var id string
+	mock.ApiAccountsMgmtV1FeatureTogglesIdQueryPostFunc = func(ctx context.Context, toggleID string) amsclient.ApiApiAccountsMgmtV1FeatureTogglesIdQueryPostRequest {
+		id = toggleID
+		return amsclient.ApiApiAccountsMgmtV1FeatureTogglesIdQueryPostRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1FeatureTogglesIdQueryPostExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1FeatureTogglesIdQueryPostRequest) (amsclient.FeatureToggle, *http.Response, error) {
+		if queryErr != nil {
+			return amsclient.FeatureToggle{}, nil, queryErr
+		}
+		return amsclient.FeatureToggle{Id: &id, Enabled: toggles[id]}, nil, nil
+	}
+	return mock
+}
+
+func newTestFactory(mock amsclient.DefaultApi, responseCache *cache.Cache) *factory.Factory {
+	f := testutil.NewAccountMgmtFactory(mock, &bytes.Buffer{})
+	f.Cache = func() *cache.Cache { return responseCache }
+	return f
+}
+
+// newPreviewTree creates a root command with a command gated by an enabled toggle,
+// a command gated by a disabled toggle and a command which is not gated
+func newPreviewTree(toggles *featureToggles) (root *cobra.Command, enabled *cobra.Command, disabled *cobra.Command, ungated *cobra.Command) {
+	run := func(*cobra.Command, []string) {}
+	root = &cobra.Command{Use: "rhoas", SilenceErrors: true, SilenceUsage: true}
+	enabled = &cobra.Command{Use: "enabled", Run: run, Annotations: map[string]string{cmdutil.FeatureToggleAnnotation: "enabled-toggle"}}
+	disabled = &cobra.Command{Use: "disabled", Annotations: map[string]string{cmdutil.FeatureToggleAnnotation: "disabled-toggle"}}
+	disabled.AddCommand(&cobra.Command{Use: "child", Run: run})
+	ungated = &cobra.Command{Use: "ungated", Run: run}
+	root.AddCommand(enabled, disabled, ungated)
+	addPreviewGating(root, toggles)
+	return root, enabled, disabled, ungated
+}
+
+func TestFeatureToggles(t *testing.T) {
+	mock := newFeatureTogglesMock(map[string]bool{"enabled-toggle": true}, nil)
+	toggles := &featureToggles{factory: newTestFactory(mock, nil)}
+	root, enabled, disabled, ungated := newPreviewTree(toggles)
+
+	for _, args := range [][]string{{"enabled"}, {"ungated"}} {
+		root.SetArgs(args)
+		if err := root.Execute(); err != nil {
+			t.Errorf("Execute(%v) error = %v", args, err)
+		}
+	}
+
+	// the subcommands of a preview command are disabled with it
+	root.SetArgs([]string{"disabled", "child"})
+	if err := root.Execute(); err == nil {
+		t.Error("Execute() of a disabled preview command did not fail")
+	}
+
+	// each toggle is queried once
+	if n := len(mock.ApiAccountsMgmtV1FeatureTogglesIdQueryPostExecuteCalls()); n != 2 {
+		t.Errorf("feature toggles queried %v times, want 2", n)
+	}
+
+	// the help hides the commands with the toggles evaluated so far
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"--help"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if enabled.Hidden || !disabled.Hidden || ungated.Hidden {
+		t.Errorf("hidden commands: enabled = %v, disabled = %v, ungated = %v", enabled.Hidden, disabled.Hidden, ungated.Hidden)
+	}
+
+	toggles = &featureToggles{factory: newTestFactory(mock, nil), enablePreview: true}
+	if enabled, err := toggles.isEnabled("disabled-toggle"); err != nil || !enabled {
+		t.Errorf("isEnabled() = %v, %v with --enable-preview", enabled, err)
+	}
+}
+
+func TestFeatureTogglesHelp(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	responseCache := cache.New(dir, "https://api.openshift.com", "alice")
+	if err = responseCache.Set(cache.FeatureTogglesKey, map[string]bool{"enabled-toggle": true}); err != nil {
+		t.Fatal(err)
+	}
+
+	mock := newFeatureTogglesMock(nil, nil)
+	toggles := &featureToggles{factory: newTestFactory(mock, responseCache)}
+	root, enabled, disabled, _ := newPreviewTree(toggles)
+
+	// the commands are hidden before any arguments are parsed, for the completion
+	if enabled.Hidden || !disabled.Hidden {
+		t.Errorf("hidden commands: enabled = %v, disabled = %v", enabled.Hidden, disabled.Hidden)
+	}
+
+	root.SetOut(&bytes.Buffer{})
+	root.SetArgs([]string{"--help"})
+	if err = root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if enabled.Hidden || !disabled.Hidden {
+		t.Errorf("hidden commands: enabled = %v, disabled = %v", enabled.Hidden, disabled.Hidden)
+	}
+
+	// the help does not query the toggles
+	if n := len(mock.ApiAccountsMgmtV1CurrentAccountGetExecuteCalls()) + len(mock.ApiAccountsMgmtV1FeatureTogglesIdQueryPostExecuteCalls()); n != 0 {
+		t.Errorf("help made %v requests, want 0", n)
+	}
+}
+
+func TestFeatureTogglesCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	responseCache := cache.New(dir, "https://api.openshift.com", "alice")
+
+	mock := newFeatureTogglesMock(map[string]bool{"enabled-toggle": true}, nil)
+	toggles := &featureToggles{factory: newTestFactory(mock, responseCache)}
+	if enabled, err := toggles.isEnabled("enabled-toggle"); err != nil || !enabled {
+		t.Fatalf("isEnabled() = %v, %v for an enabled toggle", enabled, err)
+	}
+
+	// the cached toggle is used when the toggles cannot be queried
+	offline := newFeatureTogglesMock(nil, errors.New("connection refused"))
+	toggles = &featureToggles{factory: newTestFactory(offline, responseCache)}
+	if enabled, err := toggles.isEnabled("enabled-toggle"); err != nil || !enabled {
+		t.Errorf("isEnabled() = %v, %v for a cached enabled toggle", enabled, err)
+	}
+	if n := len(offline.ApiAccountsMgmtV1FeatureTogglesIdQueryPostExecuteCalls()); n != 0 {
+		t.Errorf("cached feature toggle queried %v times", n)
+	}
+
+	// a toggle which is not known and cannot be queried fails with the error of the query
+	if _, err := toggles.isEnabled("disabled-toggle"); err == nil {
+		t.Error("isEnabled() did not fail for a toggle which cannot be queried")
+	}
+}
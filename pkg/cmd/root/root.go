@@ -10,13 +10,25 @@ import (
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 
 	"github.com/redhat-developer/app-services-cli/pkg/arguments"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/account"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/auth"
+	cachecmd "github.com/redhat-developer/app-services-cli/pkg/cmd/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/cluster"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/completion"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/context"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/kafka"
+	kafkaflags "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/logout"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/org"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/quota"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/registry"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/serviceaccount"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/support"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/usage"
 	cliversion "github.com/redhat-developer/app-services-cli/pkg/cmd/version"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -44,16 +56,38 @@ func NewRootCommand(f *factory.Factory, version string) *cobra.Command {
 	var help bool
 	fs.BoolVarP(&help, "help", "h", false, f.Localizer.MustLocalize("root.cmd.flag.help.description"))
 
+	kafkaflags.AddInstanceFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.kafka.description"))
+	_ = cmd.RegisterFlagCompletionFunc(kafkaflags.FlagKafka, func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return cmdutil.FilterValidKafkas(f, toComplete)
+	})
+
+	cache.AddFlag(fs, f.Localizer.MustLocalize("root.cmd.flag.noCache.description"))
+
+	// preview commands are only available when their feature toggle is enabled for the organization
+	toggles := &featureToggles{factory: f}
+	fs.BoolVar(&toggles.enablePreview, "enable-preview", false, f.Localizer.MustLocalize("root.cmd.flag.enablePreview.description"))
+
 	// Child commands
 	cmd.AddCommand(login.NewLoginCmd(f))
 	cmd.AddCommand(logout.NewLogoutCommand(f))
+	cmd.AddCommand(auth.NewAuthCommand(f))
 	cmd.AddCommand(kafka.NewKafkaCommand(f))
 	cmd.AddCommand(serviceaccount.NewServiceAccountCommand(f))
+	cmd.AddCommand(registry.NewServiceRegistryCommand(f))
 	cmd.AddCommand(cluster.NewClusterCommand(f))
 	cmd.AddCommand(status.NewStatusCommand(f))
+	cmd.AddCommand(quota.NewQuotaCommand(f))
+	cmd.AddCommand(usage.NewUsageCommand(f))
+	cmd.AddCommand(account.NewAccountCommand(f))
+	cmd.AddCommand(org.NewOrgCommand(f))
+	cmd.AddCommand(support.NewSupportCommand(f))
+	cmd.AddCommand(context.NewContextCommand(f))
+	cmd.AddCommand(cachecmd.NewCacheCommand(f))
 	cmd.AddCommand(completion.NewCompletionCommand(f))
 	cmd.AddCommand(whoami.NewWhoAmICmd(f))
 	cmd.AddCommand(cliversion.NewVersionCmd(f))
 
+	addPreviewGating(cmd, toggles)
+
 	return cmd
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
@@ -22,11 +24,14 @@ type Options struct {
 	Logger     func() (logging.Logger, error)
 	localizer  localize.Localizer
 
-	id    string
-	force bool
+	id      string
+	ids     []string
+	pattern string
+	regex   string
+	force   bool
 }
 
-// NewDeleteCommand creates a new command to delete a service account
+// NewDeleteCommand creates a new command to delete service accounts
 func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 	opts := &Options{
 		Config:     f.Config,
@@ -41,24 +46,38 @@ func NewDeleteCommand(f *factory.Factory) *cobra.Command {
 		Short:   opts.localizer.MustLocalize("serviceAccount.delete.cmd.shortDescription"),
 		Long:    opts.localizer.MustLocalize("serviceAccount.delete.cmd.longDescription"),
 		Example: opts.localizer.MustLocalize("serviceAccount.delete.cmd.example"),
-		Args:    cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if !opts.IO.CanPrompt() && !opts.force {
 				return flag.RequiredWhenNonInteractiveError("yes")
 			}
 
+			if opts.id != "" {
+				args = append([]string{opts.id}, args...)
+			}
+
+			if len(args) == 0 && opts.pattern == "" && opts.regex == "" {
+				return errors.New(opts.localizer.MustLocalize("serviceAccount.delete.error.noServiceAccountsSpecified"))
+			}
+
+			opts.ids, err = bulk.CollectArgs(args, opts.IO.In)
+			if err != nil {
+				return err
+			}
+
 			return runDelete(opts)
 		},
 	}
 
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.delete.flag.id.description"))
+	cmd.Flags().StringVar(&opts.pattern, "pattern", "", opts.localizer.MustLocalize("serviceAccount.delete.flag.pattern.description"))
+	cmd.Flags().StringVar(&opts.regex, "regex", "", opts.localizer.MustLocalize("serviceAccount.delete.flag.regex.description"))
 	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.delete.flag.yes.description"))
 
-	_ = cmd.MarkFlagRequired("id")
-
 	return cmd
 }
 
+// nolint:funlen
 func runDelete(opts *Options) (err error) {
 	logger, err := opts.Logger()
 	if err != nil {
@@ -70,26 +89,50 @@ func runDelete(opts *Options) (err error) {
 		return err
 	}
 
-	_, httpRes, err := connection.API().ServiceAccount().GetServiceAccountById(context.Background(), opts.id).Execute()
+	api := connection.API()
 
+	matches, err := bulk.NewMatcher(opts.localizer, opts.pattern, opts.regex)
 	if err != nil {
-		if httpRes == nil {
+		return err
+	}
+
+	if matches != nil {
+		res, _, err := api.ServiceAccount().GetServiceAccounts(context.Background()).Execute()
+		if err != nil {
 			return err
 		}
 
-		if httpRes.StatusCode == 404 {
-			return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", opts.id)))
+		for _, sa := range res.GetItems() {
+			if matches(sa.GetName()) {
+				opts.ids = append(opts.ids, sa.GetId())
+			}
 		}
 	}
 
+	ids := bulk.Unique(opts.ids)
+	if len(ids) == 0 {
+		logger.Info(opts.localizer.MustLocalize("serviceAccount.delete.log.info.noServiceAccountsMatched"))
+		return nil
+	}
+
+	// a single service account keeps the original flow of
+	// checking that the service account exists before deleting it
+	if len(ids) == 1 {
+		return deleteSingleServiceAccount(opts, api, ids[0])
+	}
+
 	if !opts.force {
+		logger.Info(opts.localizer.MustLocalize("serviceAccount.delete.log.info.serviceAccountsToDelete"))
+		for _, id := range ids {
+			logger.Info("  " + id)
+		}
+
 		var confirmDelete bool
 		promptConfirmDelete := &survey.Confirm{
-			Message: opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDelete.message", localize.NewEntry("ID", opts.id)),
+			Message: opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDeleteMultiple.message", localize.NewEntry("Count", len(ids))),
 		}
 
-		err = survey.AskOne(promptConfirmDelete, &confirmDelete)
-		if err != nil {
+		if err = survey.AskOne(promptConfirmDelete, &confirmDelete); err != nil {
 			return err
 		}
 
@@ -99,38 +142,82 @@ func runDelete(opts *Options) (err error) {
 		}
 	}
 
-	return deleteServiceAccount(opts)
-}
+	results := bulk.Run(ids, bulk.DefaultConcurrency, func(id string) error {
+		return deleteServiceAccount(opts, api, id)
+	})
 
-func deleteServiceAccount(opts *Options) error {
-	connection, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
-	if err != nil {
-		return err
+	bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.deleted"))
+
+	if failed := bulk.Failed(results); failed > 0 {
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.delete.error.someFailed", localize.NewEntry("Count", failed), localize.NewEntry("Total", len(results))))
 	}
 
+	return nil
+}
+
+func deleteSingleServiceAccount(opts *Options, api *api.API, id string) error {
 	logger, err := opts.Logger()
 	if err != nil {
 		return err
 	}
 
-	_, httpRes, err := connection.API().ServiceAccount().DeleteServiceAccountById(context.Background(), opts.id).Execute()
+	_, httpRes, err := api.ServiceAccount().GetServiceAccountById(context.Background(), id).Execute()
 
 	if err != nil {
 		if httpRes == nil {
 			return err
 		}
 
-		switch httpRes.StatusCode {
-		case 403:
-			return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.forbidden", localize.NewEntry("Operation", "delete")))
-		case 500:
-			return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.internalServerError"))
-		default:
+		if httpRes.StatusCode == 404 {
+			return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", id)))
+		}
+	}
+
+	if !opts.force {
+		var confirmDelete bool
+		promptConfirmDelete := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("serviceAccount.delete.input.confirmDelete.message", localize.NewEntry("ID", id)),
+		}
+
+		err = survey.AskOne(promptConfirmDelete, &confirmDelete)
+		if err != nil {
 			return err
 		}
+
+		if !confirmDelete {
+			logger.Debug(opts.localizer.MustLocalize("serviceAccount.delete.log.debug.deleteNotConfirmed"))
+			return nil
+		}
+	}
+
+	if err = deleteServiceAccount(opts, api, id); err != nil {
+		return err
 	}
 
 	logger.Info(opts.localizer.MustLocalize("serviceAccount.delete.log.info.deleteSuccess"))
 
 	return nil
 }
+
+func deleteServiceAccount(opts *Options, api *api.API, id string) error {
+	_, httpRes, err := api.ServiceAccount().DeleteServiceAccountById(context.Background(), id).Execute()
+
+	if err == nil {
+		return nil
+	}
+
+	if httpRes == nil {
+		return err
+	}
+
+	switch httpRes.StatusCode {
+	case 403:
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.forbidden", localize.NewEntry("Operation", "delete")))
+	case 404:
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.notFoundError", localize.NewEntry("ID", id)))
+	case 500:
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.internalServerError"))
+	default:
+		return err
+	}
+}
@@ -47,6 +47,22 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
 			}
 
+			if opts.id != "" {
+				return runDescribe(opts)
+			}
+
+			// the service account of the project context is described by default
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			if !cfg.HasServiceAccount() {
+				return errors.New(opts.localizer.MustLocalize("serviceAccount.describe.error.idRequired"))
+			}
+
+			opts.id = cfg.Services.ServiceAccount.ID
+
 			return runDescribe(opts)
 		},
 	}
@@ -54,8 +70,6 @@ func NewDescribeCommand(f *factory.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&opts.id, "id", "", opts.localizer.MustLocalize("serviceAccount.describe.flag.id.description"))
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "json", opts.localizer.MustLocalize("serviceAccount.common.flag.output.description"))
 
-	_ = cmd.MarkFlagRequired("id")
-
 	flagutil.EnableOutputFlagCompletion(cmd)
 
 	return cmd
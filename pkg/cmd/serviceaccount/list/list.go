@@ -3,17 +3,20 @@ package list
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/redhat-developer/app-services-cli/internal/config"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
 	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccount/filter"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
@@ -26,7 +29,12 @@ type Options struct {
 	IO         *iostreams.IOStreams
 	localizer  localize.Localizer
 
-	output string
+	output        string
+	owner         string
+	namePattern   string
+	createdBefore string
+	createdAfter  string
+	sortBy        string
 }
 
 // svcAcctRow contains the properties used to
@@ -37,6 +45,7 @@ type svcAcctRow struct {
 	ClientID  string `json:"clientID" header:"Client ID"`
 	Owner     string `json:"owner" header:"Owner"`
 	CreatedAt string `json:"createdAt" header:"Created At"`
+	Age       string `json:"age" header:"Age"`
 }
 
 // NewListCommand creates a new command to list service accounts
@@ -60,13 +69,24 @@ func NewListCommand(f *factory.Factory) *cobra.Command {
 				return flag.InvalidValueError("output", opts.output, flagutil.ValidOutputFormats...)
 			}
 
+			if opts.sortBy != "" && !flagutil.IsValidInput(opts.sortBy, filter.ValidSortFields...) {
+				return flag.InvalidValueError("sort-by", opts.sortBy, filter.ValidSortFields...)
+			}
+
 			return runList(opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.output, "output", "o", "", opts.localizer.MustLocalize("serviceAccount.list.flag.output.description"))
 
+	cmd.Flags().StringVar(&opts.owner, "owner", "", opts.localizer.MustLocalize("serviceAccount.list.flag.owner.description"))
+	cmd.Flags().StringVar(&opts.namePattern, "name-pattern", "", opts.localizer.MustLocalize("serviceAccount.list.flag.namePattern.description"))
+	cmd.Flags().StringVar(&opts.createdBefore, "created-before", "", opts.localizer.MustLocalize("serviceAccount.list.flag.createdBefore.description"))
+	cmd.Flags().StringVar(&opts.createdAfter, "created-after", "", opts.localizer.MustLocalize("serviceAccount.list.flag.createdAfter.description"))
+	cmd.Flags().StringVar(&opts.sortBy, "sort-by", "", opts.localizer.MustLocalize("serviceAccount.list.flag.sortBy.description"))
+
 	flagutil.EnableOutputFlagCompletion(cmd)
+	flagutil.EnableStaticFlagCompletion(cmd, "sort-by", filter.ValidSortFields)
 
 	return cmd
 }
@@ -82,13 +102,32 @@ func runList(opts *Options) (err error) {
 		return err
 	}
 
+	filterOpts, err := buildFilterOptions(opts)
+	if err != nil {
+		return err
+	}
+
 	res, _, err := connection.API().ServiceAccount().GetServiceAccounts(context.Background()).Execute()
 
 	if err != nil {
 		return err
 	}
 
-	serviceaccounts := res.GetItems()
+	serviceaccounts, err := filter.Filter(res.GetItems(), filterOpts)
+	if errors.Is(err, filter.ErrInvalidNamePattern) {
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.invalidNamePattern", localize.NewEntry("Pattern", opts.namePattern)))
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.sortBy != "" {
+		if err = filter.Sort(serviceaccounts, opts.sortBy); err != nil {
+			return err
+		}
+	}
+
+	res.SetItems(serviceaccounts)
 	if len(serviceaccounts) == 0 && opts.output == "" {
 		logger.Info(opts.localizer.MustLocalize("serviceAccount.list.log.info.noneFound"))
 		return nil
@@ -120,6 +159,7 @@ func mapResponseItemsToRows(svcAccts []kafkamgmtclient.ServiceAccountListItem) [
 			ClientID:  sa.GetClientId(),
 			Owner:     sa.GetOwner(),
 			CreatedAt: sa.GetCreatedAt().String(),
+			Age:       age.Since(sa.GetCreatedAt()),
 		}
 
 		rows = append(rows, row)
@@ -127,3 +167,29 @@ func mapResponseItemsToRows(svcAccts []kafkamgmtclient.ServiceAccountListItem) [
 
 	return rows
 }
+
+// build the filter criteria from the command flags
+func buildFilterOptions(opts *Options) (*filter.Options, error) {
+	filterOpts := &filter.Options{
+		Owner:       opts.owner,
+		NamePattern: opts.namePattern,
+	}
+
+	var err error
+	if opts.createdBefore != "" {
+		if filterOpts.CreatedBefore, err = filter.ParseDate(opts.createdBefore); err != nil {
+			return nil, flag.InvalidValueError("created-before", opts.createdBefore)
+		}
+	}
+	if opts.createdAfter != "" {
+		if filterOpts.CreatedAfter, err = filter.ParseDate(opts.createdAfter); err != nil {
+			return nil, flag.InvalidValueError("created-after", opts.createdAfter)
+		}
+	}
+
+	if !filterOpts.CreatedBefore.IsZero() && !filterOpts.CreatedAfter.IsZero() && !filterOpts.CreatedAfter.Before(filterOpts.CreatedBefore) {
+		return nil, errors.New(opts.localizer.MustLocalize("serviceAccount.list.error.invalidDateRange"))
+	}
+
+	return filterOpts, nil
+}
@@ -0,0 +1,189 @@
+package prune
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/bulk"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccount/filter"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+
+	olderThan   string
+	namePattern string
+	owner       string
+	dryRun      bool
+	force       bool
+}
+
+// pruneRow contains the properties used to
+// display a service account which will be deleted
+type pruneRow struct {
+	ID    string `header:"ID"`
+	Name  string `header:"Name"`
+	Owner string `header:"Owner"`
+	Age   string `header:"Age"`
+}
+
+// NewPruneCommand creates a new command to delete old service accounts
+func NewPruneCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("serviceAccount.prune.cmd.use"),
+		Short:   opts.localizer.MustLocalize("serviceAccount.prune.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("serviceAccount.prune.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("serviceAccount.prune.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !opts.IO.CanPrompt() && !opts.force && !opts.dryRun {
+				return flag.RequiredWhenNonInteractiveError("yes")
+			}
+
+			return runPrune(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.olderThan, "older-than", "", opts.localizer.MustLocalize("serviceAccount.prune.flag.olderThan.description"))
+	cmd.Flags().StringVar(&opts.namePattern, "name-pattern", "", opts.localizer.MustLocalize("serviceAccount.prune.flag.namePattern.description"))
+	cmd.Flags().StringVar(&opts.owner, "owner", "", opts.localizer.MustLocalize("serviceAccount.prune.flag.owner.description"))
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, opts.localizer.MustLocalize("serviceAccount.prune.flag.dryRun.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("serviceAccount.prune.flag.yes.description"))
+
+	_ = cmd.MarkFlagRequired("older-than")
+
+	return cmd
+}
+
+// nolint:funlen
+func runPrune(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	maxAge, err := age.Parse(opts.olderThan)
+	if err != nil {
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.prune.error.invalidOlderThan", localize.NewEntry("Value", opts.olderThan)))
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+
+	res, _, err := api.ServiceAccount().GetServiceAccounts(context.Background()).Execute()
+	if err != nil {
+		return err
+	}
+
+	filterOpts := &filter.Options{
+		Owner:         opts.owner,
+		NamePattern:   opts.namePattern,
+		CreatedBefore: time.Now().Add(-maxAge),
+	}
+
+	serviceAccounts, err := filter.Filter(res.GetItems(), filterOpts)
+	if errors.Is(err, filter.ErrInvalidNamePattern) {
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.common.error.invalidNamePattern", localize.NewEntry("Pattern", opts.namePattern)))
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(serviceAccounts) == 0 {
+		logger.Info(opts.localizer.MustLocalize("serviceAccount.prune.log.info.noneFound"))
+		return nil
+	}
+
+	_ = filter.Sort(serviceAccounts, filter.SortByCreatedAt)
+
+	dump.Table(opts.IO.Out, mapResponseItemsToRows(serviceAccounts))
+	logger.Info("")
+
+	countTmplEntry := localize.NewEntry("Count", len(serviceAccounts))
+	if opts.dryRun {
+		logger.Info(opts.localizer.MustLocalize("serviceAccount.prune.log.info.dryRun", countTmplEntry))
+		return nil
+	}
+
+	if !opts.force {
+		var confirmPrune bool
+		promptConfirmPrune := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("serviceAccount.prune.input.confirmPrune.message", countTmplEntry),
+		}
+
+		if err = survey.AskOne(promptConfirmPrune, &confirmPrune); err != nil {
+			return err
+		}
+
+		if !confirmPrune {
+			logger.Debug(opts.localizer.MustLocalize("serviceAccount.prune.log.debug.pruneNotConfirmed"))
+			return nil
+		}
+	}
+
+	ids := []string{}
+	for _, sa := range serviceAccounts {
+		ids = append(ids, sa.GetId())
+	}
+
+	results := bulk.Run(ids, bulk.DefaultConcurrency, func(id string) error {
+		_, _, err := api.ServiceAccount().DeleteServiceAccountById(context.Background(), id).Execute()
+		return err
+	})
+
+	bulk.PrintSummary(opts.IO.Out, opts.localizer, results, opts.localizer.MustLocalize("common.bulk.status.deleted"))
+
+	if failed := bulk.Failed(results); failed > 0 {
+		return errors.New(opts.localizer.MustLocalize("serviceAccount.prune.error.someFailed", localize.NewEntry("Count", failed)))
+	}
+
+	logger.Info(opts.localizer.MustLocalize("serviceAccount.prune.log.info.pruneSuccess", countTmplEntry))
+
+	return nil
+}
+
+func mapResponseItemsToRows(svcAccts []kafkamgmtclient.ServiceAccountListItem) []pruneRow {
+	rows := []pruneRow{}
+
+	for _, sa := range svcAccts {
+		row := pruneRow{
+			ID:    sa.GetId(),
+			Name:  sa.GetName(),
+			Owner: sa.GetOwner(),
+			Age:   age.Since(sa.GetCreatedAt()),
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
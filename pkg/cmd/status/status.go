@@ -6,16 +6,17 @@ import (
 	"errors"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	"github.com/redhat-developer/app-services-cli/pkg/cmdutil"
 	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
 	"github.com/redhat-developer/app-services-cli/pkg/localize"
 
-	"github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
-
 	"github.com/redhat-developer/app-services-cli/internal/config"
 	"github.com/redhat-developer/app-services-cli/pkg/dump"
 	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/service"
 	pkgStatus "github.com/redhat-developer/app-services-cli/pkg/status"
 
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
@@ -23,21 +24,20 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-const (
-	kafkaSvcName = "kafka"
-)
-
-var validServices = []string{kafkaSvcName}
-
 type Options struct {
 	IO         *iostreams.IOStreams
 	Config     config.IConfig
 	Logger     func() (logging.Logger, error)
 	Connection factory.ConnectionFunc
+	Services   *service.Registry
 	localizer  localize.Localizer
 
 	outputFormat string
-	services     []string
+	all          bool
+	services     []service.Provider
+
+	// handleStaleInstance explains what to do when the status of a service failed because its current instance no longer exists
+	handleStaleInstance func(err error) (bool, error)
 }
 
 func NewStatusCommand(f *factory.Factory) *cobra.Command {
@@ -46,10 +46,15 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 		Config:     f.Config,
 		Connection: f.Connection,
 		Logger:     f.Logger,
-		services:   validServices,
+		Services:   f.Services,
 		localizer:  f.Localizer,
+		handleStaleInstance: func(err error) (bool, error) {
+			return cmdutil.HandleStaleKafkaInstance(f, err)
+		},
 	}
 
+	validServices := opts.Services.Names()
+
 	cmd := &cobra.Command{
 		Use:       opts.localizer.MustLocalize("status.cmd.use"),
 		Short:     opts.localizer.MustLocalize("status.cmd.shortDescription"),
@@ -58,19 +63,28 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 		ValidArgs: validServices,
 		Args:      cobra.RangeArgs(0, len(validServices)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			validOutputFormats := flagutil.ValidOutputFormats
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			if opts.all {
+				if len(args) > 0 {
+					return errors.New(opts.localizer.MustLocalize("status.error.allWithServices"))
+				}
+				return runStatusAll(opts)
+			}
+
+			opts.services = opts.Services.Providers()
 			if len(args) > 0 {
+				opts.services = []service.Provider{}
 				for _, s := range args {
-					if !flags.IsValidInput(s, validServices...) {
+					p, ok := opts.Services.Get(s)
+					if !ok {
 						return errors.New(opts.localizer.MustLocalize("status.error.args.error.unknownServiceError", localize.NewEntry("ServiceName", s)))
 					}
+					opts.services = append(opts.services, p)
 				}
-
-				opts.services = args
-			}
-
-			validOutputFormats := flagutil.ValidOutputFormats
-			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
-				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
 			}
 
 			return runStatus(opts)
@@ -78,6 +92,7 @@ func NewStatusCommand(f *factory.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("status.flag.output.description"))
+	cmd.Flags().BoolVar(&opts.all, "all", false, opts.localizer.MustLocalize("status.flag.all.description"))
 
 	flagutil.EnableOutputFlagCompletion(cmd)
 
@@ -103,7 +118,11 @@ func runStatus(opts *Options) error {
 	}
 
 	if len(opts.services) > 0 {
-		logger.Debug(opts.localizer.MustLocalize("status.log.debug.requestingStatusOfServices"), opts.services)
+		names := []string{}
+		for _, p := range opts.services {
+			names = append(names, p.Name())
+		}
+		logger.Debug(opts.localizer.MustLocalize("status.log.debug.requestingStatusOfServices"), names)
 	}
 
 	status, ok, err := pkgStatus.Get(context.Background(), pkgOpts)
@@ -122,14 +141,78 @@ func runStatus(opts *Options) error {
 	case "json":
 		data, _ := json.Marshal(status)
 		_ = dump.JSON(stdout, data)
-		return nil
 	case "yaml", "yml":
 		data, _ := yaml.Marshal(status)
 		_ = dump.YAML(stdout, data)
+	default:
+		pkgStatus.Print(stdout, status)
+	}
+
+	for _, failed := range status.Failed() {
+		logger.Info("")
+		handled, err := opts.handleStaleInstance(failed.Error)
+		if err != nil {
+			return err
+		}
+		if handled {
+			continue
+		}
+		logger.Info(opts.localizer.MustLocalize("status.log.info.useAnotherInstance",
+			localize.NewEntry("Title", failed.Title),
+			localize.NewEntry("ServiceName", failed.Name),
+		))
+	}
+
+	return nil
+}
+
+// runStatusAll checks the health of all the Kafka instances, and fails if any of them is unhealthy
+func runStatusAll(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	// the admin servers of the instances are reached with the MAS-SSO token
+	conn, err := opts.Connection(connection.DefaultConfigRequireMasAuth)
+	if err != nil {
+		return err
+	}
+
+	health, err := kafka.GetHealth(context.Background(), conn.API())
+	if err != nil {
+		return err
+	}
+
+	if len(health) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("status.log.info.noKafkaInstances"))
 		return nil
 	}
 
-	pkgStatus.Print(stdout, status)
+	stdout := opts.IO.Out
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(health)
+		_ = dump.JSON(stdout, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(health)
+		_ = dump.YAML(stdout, data)
+	default:
+		dump.Table(stdout, health)
+	}
+
+	unhealthy := 0
+	for _, h := range health {
+		if !h.Healthy {
+			unhealthy++
+		}
+	}
+	if unhealthy > 0 {
+		return errors.New(opts.localizer.MustLocalize("status.error.unhealthyInstances",
+			localize.NewEntry("Count", unhealthy),
+			localize.NewEntry("Total", len(health)),
+		))
+	}
 
 	return nil
 }
@@ -0,0 +1,23 @@
+// Package support contains commands for getting help from Red Hat support
+package support
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/support/supportcase"
+	"github.com/spf13/cobra"
+)
+
+func NewSupportCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("support.cmd.use"),
+		Short: f.Localizer.MustLocalize("support.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("support.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		supportcase.NewCaseCommand(f),
+	)
+
+	return cmd
+}
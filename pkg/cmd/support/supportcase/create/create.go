@@ -0,0 +1,219 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/build"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/support"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/spf13/cobra"
+)
+
+type Options struct {
+	kafkaID       string
+	summary       string
+	description   string
+	severity      string
+	noDebugBundle bool
+	force         bool
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewCreateCommand creates a command to open a support case about a Kafka instance
+func NewCreateCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("support.case.create.cmd.use"),
+		Short:   opts.localizer.MustLocalize("support.case.create.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("support.case.create.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("support.case.create.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !flagutil.IsValidInput(opts.severity, accountmgmtutil.SupportCaseSeverities...) {
+				return flag.InvalidValueError("severity", opts.severity, accountmgmtutil.SupportCaseSeverities...)
+			}
+
+			if !opts.IO.CanPrompt() {
+				if opts.summary == "" {
+					return flag.RequiredWhenNonInteractiveError("summary")
+				}
+				if !opts.force {
+					return flag.RequiredWhenNonInteractiveError("yes")
+				}
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runCreate(opts, cfg)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.summary, "summary", "", opts.localizer.MustLocalize("support.case.create.flag.summary.description"))
+	cmd.Flags().StringVar(&opts.description, "description", "", opts.localizer.MustLocalize("support.case.create.flag.description.description"))
+	cmd.Flags().StringVar(&opts.severity, "severity", "Normal", opts.localizer.MustLocalize("support.case.create.flag.severity.description"))
+	cmd.Flags().BoolVar(&opts.noDebugBundle, "no-debug-bundle", false, opts.localizer.MustLocalize("support.case.create.flag.noDebugBundle.description"))
+	cmd.Flags().BoolVarP(&opts.force, "yes", "y", false, opts.localizer.MustLocalize("support.case.create.flag.yes.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "severity", accountmgmtutil.SupportCaseSeverities)
+
+	return cmd
+}
+
+// nolint:funlen
+func runCreate(opts *Options, cfg *config.Config) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API()
+	ctx := context.Background()
+
+	kafkaInstance, _, err := kafka.GetKafkaByID(ctx, api.Kafka(), opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	if err = promptCaseDetails(opts); err != nil {
+		return err
+	}
+
+	description := buildDescription(opts, cfg, kafkaInstance)
+
+	request := amsclient.SupportCasesRequest{
+		Summary:     opts.summary,
+		Description: description,
+		Severity:    opts.severity,
+		ClusterId:   &opts.kafkaID,
+	}
+	product := accountmgmtutil.SupportProductKafka
+	request.Product = &product
+
+	// the case is still useful without the subscription, as it contains the ID of the instance
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api.AccountMgmt(), opts.kafkaID)
+	if err != nil {
+		logger.Debug(opts.localizer.MustLocalize("support.case.create.log.debug.subscriptionNotFound", localize.NewEntry("Error", err)))
+	} else {
+		request.SubscriptionId = subscription.Id
+	}
+
+	if !opts.force {
+		fmt.Fprintln(opts.IO.Out, opts.summary)
+		fmt.Fprintln(opts.IO.Out)
+		fmt.Fprintln(opts.IO.Out, description)
+		logger.Info("")
+
+		var confirmCreate bool
+		promptConfirmCreate := &survey.Confirm{
+			Message: opts.localizer.MustLocalize("support.case.create.input.confirmCreate.message"),
+		}
+		if err = survey.AskOne(promptConfirmCreate, &confirmCreate); err != nil {
+			return err
+		}
+
+		if !confirmCreate {
+			logger.Debug(opts.localizer.MustLocalize("support.case.create.log.debug.createNotConfirmed"))
+			return nil
+		}
+	}
+
+	supportCase, err := accountmgmtutil.CreateSupportCase(ctx, api.AccountMgmt(), request)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(opts.localizer.MustLocalize("support.case.create.log.info.createSuccess", localize.NewEntry("CaseNumber", supportCase.GetCaseNumber())))
+	if supportCase.GetUri() != "" {
+		logger.Info(supportCase.GetUri())
+	}
+
+	return nil
+}
+
+// promptCaseDetails asks for the summary and description of the case when they were not given as flags
+func promptCaseDetails(opts *Options) error {
+	if opts.summary == "" {
+		promptSummary := &survey.Input{
+			Message: opts.localizer.MustLocalize("support.case.create.input.summary.message"),
+		}
+		if err := survey.AskOne(promptSummary, &opts.summary, survey.WithValidator(survey.Required)); err != nil {
+			return err
+		}
+	}
+
+	if opts.description == "" && opts.IO.CanPrompt() {
+		promptDescription := &survey.Multiline{
+			Message: opts.localizer.MustLocalize("support.case.create.input.description.message"),
+		}
+		if err := survey.AskOne(promptDescription, &opts.description); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildDescription returns the description of the user, followed by the details of the Kafka instance and the debug bundle
+func buildDescription(opts *Options, cfg *config.Config, kafkaInstance *kafkamgmtclient.KafkaRequest) string {
+	details := opts.localizer.MustLocalize("support.case.create.description.details",
+		localize.NewEntry("ID", kafkaInstance.GetId()),
+		localize.NewEntry("Name", kafkaInstance.GetName()),
+		localize.NewEntry("Status", kafkaInstance.GetStatus()),
+		localize.NewEntry("FailedReason", kafkaInstance.GetFailedReason()),
+		localize.NewEntry("Provider", kafkaInstance.GetCloudProvider()),
+		localize.NewEntry("Region", kafkaInstance.GetRegion()),
+		localize.NewEntry("Version", build.Version),
+	)
+
+	description := details
+	if opts.description != "" {
+		description = opts.description + "\n\n" + details
+	}
+
+	if !opts.noDebugBundle {
+		bundle := support.NewBundle(build.Version, cfg, kafkaInstance)
+		description += "\n\n" + opts.localizer.MustLocalize("support.case.create.description.debugBundle", localize.NewEntry("Bundle", bundle.String()))
+	}
+
+	return description
+}
@@ -0,0 +1,134 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// caseRow is a support case printed to a table
+type caseRow struct {
+	CaseNumber string `header:"Case"`
+	Summary    string `header:"Summary"`
+	Severity   string `header:"Severity"`
+	Status     string `header:"Status"`
+}
+
+type Options struct {
+	kafkaID      string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Config     config.IConfig
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewListCommand creates a command to list the support cases of a Kafka instance
+func NewListCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("support.case.list.cmd.use"),
+		Short:   opts.localizer.MustLocalize("support.case.list.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("support.case.list.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("support.case.list.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, flagutil.ValidOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, flagutil.ValidOutputFormats...)
+			}
+
+			cfg, err := opts.Config.Load()
+			if err != nil {
+				return err
+			}
+
+			// the instance can be selected with the global --kafka flag
+			if !cfg.HasKafka() {
+				return errors.New(opts.localizer.MustLocalize("kafka.common.error.noKafkaSelected"))
+			}
+			opts.kafkaID = cfg.Services.Kafka.ClusterID
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("support.case.list.flag.output.description"))
+
+	flagutil.EnableOutputFlagCompletion(cmd)
+
+	return cmd
+}
+
+func runList(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	subscription, err := accountmgmtutil.GetKafkaSubscription(ctx, api, opts.kafkaID)
+	if err != nil {
+		return err
+	}
+
+	cases, err := accountmgmtutil.ListSupportCases(ctx, api, subscription.GetId())
+	if err != nil {
+		return err
+	}
+
+	if len(cases) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("support.case.list.log.info.noCases"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(cases)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(cases)
+		_ = dump.YAML(opts.IO.Out, data)
+	default:
+		rows := []caseRow{}
+		for _, c := range cases {
+			rows = append(rows, caseRow{
+				CaseNumber: c.GetCaseNumber(),
+				Summary:    c.GetSummary(),
+				Severity:   c.GetSeverity(),
+				Status:     c.GetStatus(),
+			})
+		}
+		dump.Table(opts.IO.Out, rows)
+	}
+
+	return nil
+}
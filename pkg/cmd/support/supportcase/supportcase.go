@@ -0,0 +1,25 @@
+// Package supportcase contains commands for managing support cases about Kafka instances
+package supportcase
+
+import (
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/support/supportcase/create"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/support/supportcase/list"
+	"github.com/spf13/cobra"
+)
+
+func NewCaseCommand(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   f.Localizer.MustLocalize("support.case.cmd.use"),
+		Short: f.Localizer.MustLocalize("support.case.cmd.shortDescription"),
+		Long:  f.Localizer.MustLocalize("support.case.cmd.longDescription"),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		create.NewCreateCommand(f),
+		list.NewListCommand(f),
+	)
+
+	return cmd
+}
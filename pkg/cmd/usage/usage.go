@@ -0,0 +1,168 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/flag"
+	flagutil "github.com/redhat-developer/app-services-cli/pkg/cmdutil/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/dump"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	pkgUsage "github.com/redhat-developer/app-services-cli/pkg/usage"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const gibibyte = 1024 * 1024 * 1024
+
+var validOutputFormats = append(append([]string{}, flagutil.ValidOutputFormats...), "csv")
+
+// usageReport is the current consumption of the organization
+type usageReport struct {
+	Since time.Time          `json:"since" yaml:"since"`
+	Usage []pkgUsage.Summary `json:"usage" yaml:"usage"`
+}
+
+// usageRow is the consumption of a product and SKU printed to a table
+type usageRow struct {
+	Product      string `header:"Product"`
+	SKU          string `header:"SKU"`
+	Instances    int    `header:"Instances"`
+	CPU          string `header:"CPU (cores)"`
+	Memory       string `header:"Memory (GiB)"`
+	LastReported string `header:"Last Reported"`
+}
+
+type Options struct {
+	since        string
+	outputFormat string
+
+	IO         *iostreams.IOStreams
+	Connection factory.ConnectionFunc
+	Logger     func() (logging.Logger, error)
+	localizer  localize.Localizer
+}
+
+// NewUsageCommand creates a command to report the consumption of the organization of the user
+func NewUsageCommand(f *factory.Factory) *cobra.Command {
+	opts := &Options{
+		IO:         f.IOStreams,
+		Connection: f.Connection,
+		Logger:     f.Logger,
+		localizer:  f.Localizer,
+	}
+
+	cmd := &cobra.Command{
+		Use:     opts.localizer.MustLocalize("usage.cmd.use"),
+		Short:   opts.localizer.MustLocalize("usage.cmd.shortDescription"),
+		Long:    opts.localizer.MustLocalize("usage.cmd.longDescription"),
+		Example: opts.localizer.MustLocalize("usage.cmd.example"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "" && !flagutil.IsValidInput(opts.outputFormat, validOutputFormats...) {
+				return flag.InvalidValueError("output", opts.outputFormat, validOutputFormats...)
+			}
+
+			return runUsage(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.since, "since", "30d", opts.localizer.MustLocalize("usage.flag.since.description"))
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "o", "", opts.localizer.MustLocalize("usage.flag.output.description"))
+
+	flagutil.EnableStaticFlagCompletion(cmd, "output", validOutputFormats)
+
+	return cmd
+}
+
+// nolint:funlen
+func runUsage(opts *Options) error {
+	logger, err := opts.Logger()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	since, err := age.ParseTime(opts.since, now)
+	if err != nil || !since.Before(now) {
+		return flag.InvalidValueError("since", opts.since)
+	}
+
+	conn, err := opts.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return err
+	}
+
+	api := conn.API().AccountMgmt()
+	ctx := context.Background()
+
+	orgID, err := accountmgmtutil.GetOrganizationID(ctx, api)
+	if err != nil {
+		return err
+	}
+
+	products, err := accountmgmtutil.GetSubscriptionProducts(ctx, api, orgID)
+	if err != nil {
+		return err
+	}
+
+	subscriptionIDs := []string{}
+	for id := range products {
+		subscriptionIDs = append(subscriptionIDs, id)
+	}
+	sort.Strings(subscriptionIDs)
+
+	samples, err := accountmgmtutil.GetUsageSamples(ctx, api, subscriptionIDs, since)
+	if err != nil {
+		return err
+	}
+
+	report := usageReport{
+		Since: since,
+		Usage: pkgUsage.Aggregate(samples, products, since),
+	}
+
+	if len(report.Usage) == 0 && opts.outputFormat == "" {
+		logger.Info(opts.localizer.MustLocalize("usage.log.info.noUsage"))
+		return nil
+	}
+
+	switch opts.outputFormat {
+	case "json":
+		data, _ := json.Marshal(report)
+		_ = dump.JSON(opts.IO.Out, data)
+	case "yaml", "yml":
+		data, _ := yaml.Marshal(report)
+		_ = dump.YAML(opts.IO.Out, data)
+	case "csv":
+		return pkgUsage.WriteCSV(opts.IO.Out, report.Usage)
+	default:
+		printTable(opts, report)
+	}
+
+	return nil
+}
+
+func printTable(opts *Options, report usageReport) {
+	rows := []usageRow{}
+	for _, s := range report.Usage {
+		rows = append(rows, usageRow{
+			Product:      s.Product,
+			SKU:          s.SKU,
+			Instances:    s.Instances,
+			CPU:          fmt.Sprintf("%.2f", s.CPU),
+			Memory:       fmt.Sprintf("%.2f", s.Memory/gibibyte),
+			LastReported: s.LastReported.Format(time.RFC3339),
+		})
+	}
+	dump.Table(opts.IO.Out, rows)
+}
@@ -3,15 +3,23 @@ package cmdutil
 import (
 	"context"
 	"errors"
+	"net/http"
 	"os"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
 	"github.com/redhat-developer/app-services-cli/pkg/cloudprovider/cloudproviderutil"
+	"github.com/redhat-developer/app-services-cli/pkg/cloudregion/cloudregionutil"
 	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	"github.com/spf13/cobra"
 )
 
+// topicPageSize is the number of topics requested per page when listing topics
+const topicPageSize = 1000
+
 // CheckSurveyError checks the error from AlecAivazis/survey
 // if the error is from SIGINT, force exit the program quietly
 func CheckSurveyError(err error) error {
@@ -39,32 +47,64 @@ func FilterValidTopicNameArgs(f *factory.Factory, toComplete string) (validNames
 		return validNames, directive
 	}
 
-	conn, err := f.Connection(connection.DefaultConfigRequireMasAuth)
-	if err != nil {
-		return validNames, directive
-	}
-
-	api, _, err := conn.API().KafkaAdmin(cfg.Services.Kafka.ClusterID)
-	if err != nil {
-		return validNames, directive
-	}
-	req := api.GetTopics(context.Background())
-	if toComplete != "" {
-		req = req.Filter(toComplete)
+	// all topic names are cached, so that they can be completed without requesting them again
+	c := responseCache(f)
+	key := cache.TopicsKey(cfg.Services.Kafka.ClusterID)
+	var names []string
+	if !c.Get(key, cache.NamesTTL, &names) {
+		conn, err := f.Connection(connection.DefaultConfigRequireMasAuth)
+		if err != nil {
+			return validNames, directive
+		}
+
+		api, _, err := conn.API().KafkaAdmin(cfg.Services.Kafka.ClusterID)
+		if err != nil {
+			return validNames, directive
+		}
+
+		names, err = ListTopicNames(api)
+		if err != nil {
+			return validNames, directive
+		}
+		_ = c.Set(key, names)
 	}
 
-	topicRes, _, err := req.Execute()
+	return filterPrefix(names, toComplete), directive
+}
 
+// ListTopicNames returns the names of all the topics, requesting them page by page
+func ListTopicNames(api kafkainstanceclient.DefaultApi) ([]string, error) {
+	topics, _, err := ListTopics(api)
 	if err != nil {
-		return validNames, directive
+		return nil, err
 	}
 
-	items := topicRes.GetItems()
-	for _, topic := range items {
-		validNames = append(validNames, topic.GetName())
+	names := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		names = append(names, topic.GetName())
 	}
+	return names, nil
+}
 
-	return validNames, directive
+// ListTopics returns all the topics, requesting them page by page.
+// When a request fails, the HTTP response of the failed request is returned with the error.
+func ListTopics(api kafkainstanceclient.DefaultApi) ([]kafkainstanceclient.Topic, *http.Response, error) {
+	topics := []kafkainstanceclient.Topic{}
+	for {
+		topicRes, httpRes, err := api.GetTopics(context.Background()).
+			Offset(int32(len(topics))).
+			Limit(topicPageSize).
+			Execute()
+		if err != nil {
+			return nil, httpRes, err
+		}
+
+		topics = append(topics, topicRes.GetItems()...)
+
+		if len(topicRes.GetItems()) == 0 || len(topics) >= int(topicRes.GetCount()) {
+			return topics, httpRes, nil
+		}
+	}
 }
 
 // FilterValidConsumerGroups returns the list of consumer group IDs from the API
@@ -106,34 +146,51 @@ func FilterValidConsumerGroupIDs(f *factory.Factory, toComplete string) (validID
 	return validIDs, directive
 }
 
-// FilterValidKafkaNames filters Kafkas by name from the API and returns the names
+// FilterValidKafkas filters Kafkas by name from the API and returns the names
 // This is used in the cobra.ValidArgsFunction for dynamic completion of Kafka instance names
 func FilterValidKafkas(f *factory.Factory, toComplete string) (validNames []string, directive cobra.ShellCompDirective) {
+	return FilterValidInstances(f, "kafka", toComplete)
+}
+
+// FilterValidServiceRegistries filters Service Registry instances by name from the API and returns the names
+// This is used in the cobra.ValidArgsFunction for dynamic completion of Service Registry instance names
+func FilterValidServiceRegistries(f *factory.Factory, toComplete string) (validNames []string, directive cobra.ShellCompDirective) {
+	return FilterValidInstances(f, "service-registry", toComplete)
+}
+
+// FilterValidInstances returns the names of the instances of a service which start with toComplete
+// This is used in the cobra.ValidArgsFunction for dynamic completion of instance names
+func FilterValidInstances(f *factory.Factory, serviceName string, toComplete string) (validNames []string, directive cobra.ShellCompDirective) {
 	validNames = []string{}
 	directive = cobra.ShellCompDirectiveNoSpace
 
-	conn, err := f.Connection(connection.DefaultConfigSkipMasAuth)
-	if err != nil {
+	if f.Services == nil {
 		return validNames, directive
 	}
 
-	req := conn.API().Kafka().GetKafkas(context.Background())
-	if toComplete != "" {
-		searchQ := "name like " + toComplete + "%"
-		req = req.Search(searchQ)
-	}
-	kafkas, _, err := req.Execute()
-
-	if err != nil {
+	provider, ok := f.Services.Get(serviceName)
+	if !ok {
 		return validNames, directive
 	}
 
-	items := kafkas.GetItems()
-	for _, kafka := range items {
-		validNames = append(validNames, kafka.GetName())
+	// all instance names are cached, so that they can be completed without requesting them again
+	c := responseCache(f)
+	key := cache.InstancesKey(serviceName)
+	var names []string
+	if !c.Get(key, cache.NamesTTL, &names) {
+		conn, err := f.Connection(connection.DefaultConfigSkipMasAuth)
+		if err != nil {
+			return validNames, directive
+		}
+
+		names, err = provider.Complete(context.Background(), conn.API(), "")
+		if err != nil {
+			return validNames, directive
+		}
+		_ = c.Set(key, names)
 	}
 
-	return validNames, directive
+	return filterPrefix(names, toComplete), directive
 }
 
 // FetchCloudProviders returns the list of supported cloud providers for creating a Kafka instance
@@ -147,13 +204,75 @@ func FetchCloudProviders(f *factory.Factory) (validProviders []string, directive
 		return validProviders, directive
 	}
 
-	cloudProviderResponse, _, err := conn.API().Kafka().GetCloudProviders(context.Background()).Execute()
+	cloudProviders, err := cloudproviderutil.List(context.Background(), conn.API().Kafka(), responseCache(f))
 	if err != nil {
 		return validProviders, directive
 	}
 
-	cloudProviders := cloudProviderResponse.GetItems()
 	validProviders = cloudproviderutil.GetEnabledNames(cloudProviders)
 
 	return validProviders, directive
 }
+
+// FetchCloudRegions returns the list of enabled regions of a cloud provider for creating a Kafka instance
+// This is used in the cmd.RegisterFlagCompletionFunc for dynamic completion of --region
+func FetchCloudRegions(f *factory.Factory, providerID string) (validRegions []string, directive cobra.ShellCompDirective) {
+	validRegions = []string{}
+	directive = cobra.ShellCompDirectiveNoSpace
+
+	conn, err := f.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return validRegions, directive
+	}
+
+	regions, err := cloudregionutil.List(context.Background(), conn.API().Kafka(), responseCache(f), providerID)
+	if err != nil {
+		return validRegions, directive
+	}
+
+	validRegions = cloudregionutil.GetEnabledIDs(regions)
+
+	return validRegions, directive
+}
+
+// FetchOrgRoles returns the IDs of the roles which can be bound to the accounts of the organization
+// This is used in the cmd.RegisterFlagCompletionFunc for dynamic completion of --role
+func FetchOrgRoles(f *factory.Factory, toComplete string) (validRoles []string, directive cobra.ShellCompDirective) {
+	validRoles = []string{}
+	directive = cobra.ShellCompDirectiveNoSpace
+
+	conn, err := f.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return validRoles, directive
+	}
+
+	roles, _, err := conn.API().AccountMgmt().ApiAccountsMgmtV1RolesGet(context.Background()).Size(100).Execute()
+	if err != nil {
+		return validRoles, directive
+	}
+
+	for _, r := range roles.GetItems() {
+		validRoles = append(validRoles, r.GetId())
+	}
+
+	return filterPrefix(validRoles, toComplete), directive
+}
+
+// responseCache returns the cache of control plane responses of the factory, or nil when there is none
+func responseCache(f *factory.Factory) *cache.Cache {
+	if f.Cache == nil {
+		return nil
+	}
+	return f.Cache()
+}
+
+// filterPrefix returns the values which start with prefix
+func filterPrefix(values []string, prefix string) []string {
+	filtered := []string{}
+	for _, v := range values {
+		if strings.HasPrefix(v, prefix) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
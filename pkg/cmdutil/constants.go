@@ -3,4 +3,8 @@ package cmdutil
 const (
 	// The default indentation to use when printing data to stdout
 	DefaultJSONIndent = "    "
+
+	// FeatureToggleAnnotation is the annotation of a preview command with the ID of
+	// the feature toggle of the account management service which enables it
+	FeatureToggleAnnotation = "rhoas:featureToggle"
 )
@@ -0,0 +1,121 @@
+package cmdutil
+
+import (
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	kafkaflags "github.com/redhat-developer/app-services-cli/pkg/cmd/kafka/flags"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/kafkaerr"
+	"github.com/redhat-developer/app-services-cli/pkg/localize"
+)
+
+// HandleStaleKafkaInstance checks if err was caused by the current Kafka instance no longer existing.
+// When it was, the user is prompted to select another instance, or told how to do so when not running interactively.
+// It returns true if err was handled.
+func HandleStaleKafkaInstance(f *factory.Factory, err error) (bool, error) {
+	id, ok := kafkaerr.NotFoundID(err)
+	if !ok {
+		return false, nil
+	}
+
+	logger, err := f.Logger()
+	if err != nil {
+		return false, err
+	}
+
+	// the instance was selected by name or ID for this command only, but its cached ID may be stale
+	if nameOrID := kafkaflags.Instance(); nameOrID != "" {
+		if removeCachedID(f, nameOrID, id) {
+			logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.staleCachedInstance", localize.NewEntry("Name", nameOrID)))
+		}
+		return true, nil
+	}
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return false, err
+	}
+
+	provider := kafka.ServiceProvider{}
+	if provider.CurrentID(cfg) != id {
+		return false, nil
+	}
+
+	idEntry := localize.NewEntry("ID", id)
+
+	// the instance is pinned by the project context, so selecting another one would have no effect here
+	if wd, wdErr := os.Getwd(); wdErr == nil {
+		local, path, _ := config.LoadLocal(wd)
+		if local.HasKafka() {
+			if local.Kafka.Name != "" && removeCachedID(f, local.Kafka.Name, id) {
+				logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.staleCachedInstance", localize.NewEntry("Name", local.Kafka.Name)))
+				return true, nil
+			}
+			logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.staleLocalInstance", idEntry, localize.NewEntry("Path", path)))
+			return true, nil
+		}
+	}
+
+	if !f.IOStreams.CanPrompt() {
+		logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.staleInstance", idEntry))
+		return true, nil
+	}
+
+	promptSelect := &survey.Confirm{
+		Message: f.Localizer.MustLocalize("kafka.common.input.selectAnotherInstance.message", idEntry),
+		Default: true,
+	}
+
+	var selectAnother bool
+	if err = survey.AskOne(promptSelect, &selectAnother); err != nil {
+		return true, err
+	}
+
+	if !selectAnother {
+		logger.Info(f.Localizer.MustLocalize("kafka.common.log.info.staleInstance", idEntry))
+		return true, nil
+	}
+
+	conn, err := f.Connection(connection.DefaultConfigSkipMasAuth)
+	if err != nil {
+		return true, err
+	}
+
+	selectedKafka, err := kafka.InteractiveSelect(conn, logger)
+	if err != nil || selectedKafka == nil {
+		return true, err
+	}
+
+	provider.Use(cfg, selectedKafka.GetId())
+	if err = f.Config.Save(cfg); err != nil {
+		return true, err
+	}
+
+	logger.Info(f.Localizer.MustLocalize("kafka.use.log.info.useSuccess", localize.NewEntry("Name", selectedKafka.GetName())))
+
+	return true, nil
+}
+
+// removeCachedID removes the cached ID of the Kafka instance selected by nameOrID
+// if it is the ID of the instance which no longer exists, and returns true if it was removed
+func removeCachedID(f *factory.Factory, nameOrID string, id string) bool {
+	idCache, err := kafka.DefaultIDCache()
+	if err != nil {
+		return false
+	}
+
+	cfg, err := f.Config.Load()
+	if err != nil {
+		return false
+	}
+
+	if cachedID, ok := idCache.Get(cfg.APIUrl, nameOrID); !ok || cachedID != id {
+		return false
+	}
+
+	return idCache.Remove(cfg.APIUrl, nameOrID) == nil
+}
@@ -0,0 +1,63 @@
+// Package testutil provides the factories and API mocks shared by the tests of commands
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/cmd/factory"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/iostreams"
+	"github.com/redhat-developer/app-services-cli/pkg/localize/goi18n"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// NewAccountMgmtMock returns an account management API mock in which account is the current account
+func NewAccountMgmtMock(account amsclient.Account) *amsclient.DefaultApiMock {
+	mock := &amsclient.DefaultApiMock{}
+	mock.ApiAccountsMgmtV1CurrentAccountGetFunc = func(ctx context.Context) amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest {
+		return amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest{ApiService: mock}
+	}
+	mock.ApiAccountsMgmtV1CurrentAccountGetExecuteFunc = func(amsclient.ApiApiAccountsMgmtV1CurrentAccountGetRequest) (amsclient.Account, *http.Response, error) {
+		return account, nil, nil
+	}
+	return mock
+}
+
+// NewAccountMgmtFactory returns a factory whose connection uses the given account management API.
+// The output of commands is written to out, and their log messages are discarded.
+func NewAccountMgmtFactory(accountMgmt amsclient.DefaultApi, out io.Writer) *factory.Factory {
+	return newFactory(&api.API{
+		AccountMgmt: func() amsclient.DefaultApi { return accountMgmt },
+	}, out)
+}
+
+// NewKafkaMgmtFactory returns a factory whose connection uses the given Kafka management API.
+// The output of commands is written to out, and their log messages are discarded.
+func NewKafkaMgmtFactory(kafkaMgmt kafkamgmtclient.DefaultApi, out io.Writer) *factory.Factory {
+	return newFactory(&api.API{
+		Kafka: func() kafkamgmtclient.DefaultApi { return kafkaMgmt },
+	}, out)
+}
+
+func newFactory(a *api.API, out io.Writer) *factory.Factory {
+	localizer, _ := goi18n.New(nil)
+
+	return &factory.Factory{
+		IOStreams: &iostreams.IOStreams{Out: out, ErrOut: &bytes.Buffer{}},
+		Connection: func(*connection.Config) (connection.Connection, error) {
+			return &connection.ConnectionMock{
+				APIFunc: func() *api.API { return a },
+			}, nil
+		},
+		Localizer: localizer,
+		Logger: func() (logging.Logger, error) {
+			return logging.NewStdLoggerBuilder().Streams(&bytes.Buffer{}, &bytes.Buffer{}).Build()
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// Package age contains functions to parse and print the age of a resource
+package age
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+var validAgeRegexp = regexp.MustCompile(`^([0-9]+)([smhdw])$`)
+
+// Parse parses a human-readable age such as "90d", "2w" or "12h" into a duration
+// Supported units are "s" (seconds), "m" (minutes), "h" (hours), "d" (days) and "w" (weeks)
+func Parse(s string) (time.Duration, error) {
+	matches := validAgeRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return 0, fmt.Errorf(`invalid age "%v"; use a number followed by one of the units "s", "m", "h", "d", "w", for example "90d"`, s)
+	}
+
+	n, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, fmt.Errorf(`invalid age "%v": %w`, s, err)
+	}
+
+	var unit time.Duration
+	switch matches[2] {
+	case "s":
+		unit = time.Second
+	case "m":
+		unit = time.Minute
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = day
+	case "w":
+		unit = week
+	}
+
+	return time.Duration(n) * unit, nil
+}
+
+// Format prints a duration in its largest whole unit, for example "3d" or "5h"
+func Format(d time.Duration) string {
+	switch {
+	case d < 0:
+		return "0s"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d/time.Second))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < day:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return fmt.Sprintf("%dd", int(d/day))
+	}
+}
+
+// Since returns the formatted age of a resource created at t
+func Since(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return Format(time.Since(t))
+}
+
+// ParseTime parses a point in time given as an RFC3339 timestamp, a date in the format "2006-01-02",
+// or an age such as "2h" which is subtracted from now
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	if d, err := Parse(s); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf(`invalid time "%v"; use an RFC3339 timestamp, a date in the format "YYYY-MM-DD" or an age such as "2h"`, s)
+}
@@ -0,0 +1,131 @@
+package age
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "parses days",
+			arg:  "90d",
+			want: 90 * 24 * time.Hour,
+		},
+		{
+			name: "parses weeks",
+			arg:  "2w",
+			want: 14 * 24 * time.Hour,
+		},
+		{
+			name: "parses hours",
+			arg:  "12h",
+			want: 12 * time.Hour,
+		},
+		{
+			name:    "fails without a unit",
+			arg:     "90",
+			wantErr: true,
+		},
+		{
+			name:    "fails with an unknown unit",
+			arg:     "3y",
+			wantErr: true,
+		},
+		{
+			name:    "fails with a negative value",
+			arg:     "-1d",
+			wantErr: true,
+		},
+		{
+			name:    "fails when empty",
+			arg:     "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  time.Duration
+		want string
+	}{
+		{
+			name: "formats seconds",
+			arg:  42 * time.Second,
+			want: "42s",
+		},
+		{
+			name: "formats minutes",
+			arg:  90 * time.Second,
+			want: "1m",
+		},
+		{
+			name: "formats hours",
+			arg:  5*time.Hour + 30*time.Minute,
+			want: "5h",
+		},
+		{
+			name: "formats days",
+			arg:  100 * 24 * time.Hour,
+			want: "100d",
+		},
+		{
+			name: "negative durations are zero",
+			arg:  -time.Hour,
+			want: "0s",
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Format(tt.arg); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		arg     string
+		want    time.Time
+		wantErr bool
+	}{
+		{arg: "2021-05-30T08:15:00Z", want: time.Date(2021, 5, 30, 8, 15, 0, 0, time.UTC)},
+		{arg: "2021-05-30", want: time.Date(2021, 5, 30, 0, 0, 0, 0, time.UTC)},
+		{arg: "2h", want: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)},
+		{arg: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.arg, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTime(%v) error = %v, wantErr %v", tt.arg, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%v) = %v, want %v", tt.arg, got, tt.want)
+		}
+	}
+}
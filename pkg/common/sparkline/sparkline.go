@@ -0,0 +1,31 @@
+// Package sparkline contains functions to draw series of values as small inline charts
+package sparkline
+
+import "math"
+
+var ticks = []rune("▁▂▃▄▅▆▇█")
+
+// Draw returns a line of block characters whose heights are proportional to values.
+// The lowest tick is used for values which are equal to the minimum of the series.
+func Draw(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	line := make([]rune, len(values))
+	for i, v := range values {
+		tick := 0
+		if max > min {
+			tick = int((v - min) / (max - min) * float64(len(ticks)-1))
+		}
+		line[i] = ticks[tick]
+	}
+
+	return string(line)
+}
@@ -0,0 +1,23 @@
+package sparkline
+
+import "testing"
+
+func TestDraw(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   string
+	}{
+		{name: "empty", values: nil, want: ""},
+		{name: "constant", values: []float64{3, 3, 3}, want: "▁▁▁"},
+		{name: "increasing", values: []float64{0, 1, 2, 3, 4, 5, 6, 7}, want: "▁▂▃▄▅▆▇█"},
+		{name: "negative", values: []float64{-10, 0, 10}, want: "▁▄█"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Draw(tt.values); got != tt.want {
+				t.Errorf("Draw(%v) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+}
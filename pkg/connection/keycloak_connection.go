@@ -7,16 +7,22 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	kafkainstance "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal"
 	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
 	kafkamgmt "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1"
 	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	srsmgmt "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
 
 	"golang.org/x/oauth2"
 
 	"github.com/redhat-developer/app-services-cli/pkg/api/ams/amsclient"
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
 	"github.com/redhat-developer/app-services-cli/pkg/kafka/kafkaerr"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/artifact"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/registryerr"
 
 	"github.com/redhat-developer/app-services-cli/internal/config"
 	"github.com/redhat-developer/app-services-cli/pkg/api/kas"
@@ -175,42 +181,103 @@ func (c *KeycloakConnection) API() *api.API {
 		return apiClient.SecurityApi
 	}
 
-	kafkaAdminAPIFunc := func(kafkaID string) (kafkainstanceclient.DefaultApi, *kafkamgmtclient.KafkaRequest, error) {
+	// get a Kafka instance which is ready to be used
+	readyKafkaFunc := func(kafkaID string) (*kafkamgmtclient.KafkaRequest, error) {
 		api := kafkaAPIFunc()
 
 		kafkaInstance, resp, err := api.GetKafkaById(context.Background(), kafkaID).Execute()
-		defer resp.Body.Close()
+		if resp != nil {
+			defer resp.Body.Close()
+		}
 		if kas.IsErr(err, kas.ErrorNotFound) {
-			return nil, nil, kafkaerr.NotFoundByIDError(kafkaID)
+			return nil, kafkaerr.NotFoundByIDError(kafkaID)
 		} else if err != nil {
-			return nil, nil, fmt.Errorf("%w", err)
+			return nil, fmt.Errorf("%w", err)
 		}
 
 		kafkaStatus := kafkaInstance.GetStatus()
 		if kafkaStatus != "ready" {
 			err = fmt.Errorf(`Kafka instance "%v" is not ready yet`, kafkaInstance.GetName())
 
-			return nil, nil, err
+			return nil, err
 		}
 
 		bootstrapURL := kafkaInstance.GetBootstrapServerHost()
 		if bootstrapURL == "" {
 			err = fmt.Errorf(`bootstrap URL is missing for Kafka instance "%v"`, kafkaInstance.GetName())
 
+			return nil, err
+		}
+
+		return &kafkaInstance, nil
+	}
+
+	kafkaAdminAPIFunc := func(kafkaID string) (kafkainstanceclient.DefaultApi, *kafkamgmtclient.KafkaRequest, error) {
+		kafkaInstance, err := readyKafkaFunc(kafkaID)
+		if err != nil {
 			return nil, nil, err
 		}
 
 		// create the client
-		apiClient := c.createKafkaAdminAPI(bootstrapURL)
+		apiClient := c.createKafkaAdminAPI(kafkaInstance.GetBootstrapServerHost())
+
+		return *apiClient, kafkaInstance, nil
+	}
+
+	kafkaDataPlaneFunc := func(kafkaID string) (*dataplane.Config, *kafkamgmtclient.KafkaRequest, error) {
+		kafkaInstance, err := readyKafkaFunc(kafkaID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dataPlaneConfig := &dataplane.Config{
+			BootstrapServer: kafkaInstance.GetBootstrapServerHost(),
+			AccessToken:     c.MASToken.AccessToken,
+		}
+
+		return dataPlaneConfig, kafkaInstance, nil
+	}
 
-		return *apiClient, &kafkaInstance, nil
+	serviceRegistryMgmtAPIFunc := func() srsmgmtclient.RegistriesApi {
+		apiClient := c.createServiceRegistryMgmtAPIClient()
+
+		return apiClient.RegistriesApi
+	}
+
+	serviceRegistryArtifactFunc := func(registryID string) (*artifact.Client, *srsmgmtclient.Registry, error) {
+		id, err := strconv.ParseInt(registryID, 10, 32)
+		if err != nil {
+			return nil, nil, registryerr.InvalidIDError(registryID)
+		}
+
+		registry, resp, err := serviceRegistryMgmtAPIFunc().GetRegistry(context.Background(), int32(id)).Execute()
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil, registryerr.NotFoundByIDError(registryID)
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("%w", err)
+		}
+
+		if registry.GetStatus() != srsmgmtclient.AVAILABLE {
+			return nil, nil, fmt.Errorf(`Service Registry instance "%v" is not ready yet`, registry.GetName())
+		}
+
+		if registry.GetRegistryUrl() == "" {
+			return nil, nil, fmt.Errorf(`registry URL is missing for Service Registry instance "%v"`, registry.GetName())
+		}
+
+		client := artifact.NewClient(registry.GetRegistryUrl(), c.createOAuthTransport(c.Token.AccessToken))
+
+		return client, &registry, nil
 	}
 
 	return &api.API{
-		Kafka:          kafkaAPIFunc,
-		ServiceAccount: serviceAccountAPIFunc,
-		KafkaAdmin:     kafkaAdminAPIFunc,
-		AccountMgmt:    amsAPIFunc,
+		Kafka:                   kafkaAPIFunc,
+		ServiceAccount:          serviceAccountAPIFunc,
+		KafkaAdmin:              kafkaAdminAPIFunc,
+		KafkaDataPlane:          kafkaDataPlaneFunc,
+		ServiceRegistryMgmt:     serviceRegistryMgmtAPIFunc,
+		ServiceRegistryArtifact: serviceRegistryArtifactFunc,
+		AccountMgmt:             amsAPIFunc,
 	}
 }
 
@@ -226,6 +293,15 @@ func (c *KeycloakConnection) createKafkaAPIClient() *kafkamgmtclient.APIClient {
 	return client
 }
 
+// Create a new Service Registry management API client
+func (c *KeycloakConnection) createServiceRegistryMgmtAPIClient() *srsmgmtclient.APIClient {
+	return srsmgmt.NewAPIClient(&srsmgmt.Config{
+		BaseURL:    c.apiURL.String(),
+		Debug:      c.logger.DebugEnabled(),
+		HTTPClient: c.createOAuthTransport(c.Token.AccessToken),
+	})
+}
+
 // Create a new KafkaAdmin API client
 func (c *KeycloakConnection) createKafkaAdminAPI(bootstrapURL string) *kafkainstanceclient.DefaultApi {
 	host, port, _ := net.SplitHostPort(bootstrapURL)
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -38,3 +39,37 @@ func GetKafkaByName(ctx context.Context, api kafkamgmtclient.DefaultApi, name st
 
 	return &kafkaReq, httpResponse, err
 }
+
+// GetKafkaByNameOrID gets the Kafka instance with the given name,
+// or with the given ID when there is no instance with this name
+func GetKafkaByNameOrID(ctx context.Context, api kafkamgmtclient.DefaultApi, nameOrID string) (*kafkamgmtclient.KafkaRequest, *http.Response, error) {
+	if ValidateName(nameOrID) != nil {
+		return GetKafkaByID(ctx, api, nameOrID)
+	}
+
+	kafkaReq, httpResponse, err := GetKafkaByName(ctx, api, nameOrID)
+	if err == nil || !errors.Is(err, kafkaerr.NotFoundByNameErr) {
+		return kafkaReq, httpResponse, err
+	}
+
+	kafkaReq, httpResponse, err = GetKafkaByID(ctx, api, nameOrID)
+	if err != nil && errors.Is(err, kafkaerr.NotFoundByIDErr) {
+		// the value was more likely meant as a name
+		return nil, httpResponse, kafkaerr.NotFoundByNameError(nameOrID)
+	}
+
+	return kafkaReq, httpResponse, err
+}
+
+// ResolveIDs replaces each of the given names or IDs of Kafka instances with the ID of the instance
+func ResolveIDs(ctx context.Context, api kafkamgmtclient.DefaultApi, namesOrIDs ...*string) error {
+	for _, nameOrID := range namesOrIDs {
+		kafkaReq, _, err := GetKafkaByNameOrID(ctx, api, *nameOrID)
+		if err != nil {
+			return err
+		}
+		*nameOrID = kafkaReq.GetId()
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/kafkaerr"
+	"github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// newFleetManager serves the given Kafka instances from a fake Kafka management API
+func newFleetManager(t *testing.T, instances ...kafkamgmtclient.KafkaRequest) kafkamgmtclient.DefaultApi {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		const kafkasPath = "/api/kafkas_mgmt/v1/kafkas"
+		if r.URL.Path == kafkasPath {
+			items := []kafkamgmtclient.KafkaRequest{}
+			for _, k := range instances {
+				if r.URL.Query().Get("search") == "name = "+k.GetName() {
+					items = append(items, k)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(kafkamgmtclient.KafkaRequestList{Items: items, Total: int32(len(items))})
+			return
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, kafkasPath+"/")
+		for _, k := range instances {
+			if k.GetId() == id {
+				_ = json.NewEncoder(w).Encode(k)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"kind": "Error", "code": "KAFKAS-MGMT-7", "reason": "not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return kafkamgmt.NewAPIClient(&kafkamgmt.Config{BaseURL: server.URL}).DefaultApi
+}
+
+func newKafka(id, name string) kafkamgmtclient.KafkaRequest {
+	k := kafkamgmtclient.KafkaRequest{}
+	k.SetId(id)
+	k.SetName(name)
+	return k
+}
+
+func TestGetKafkaByNameOrID(t *testing.T) {
+	api := newFleetManager(t,
+		newKafka("1iSY6RQ3JKI8Q0OTmjQFd3ocFRg", "my-kafka"),
+		newKafka("c3bm8v7jn1j1n6a5o0hg", "other-kafka"),
+	)
+
+	tests := []struct {
+		nameOrID string
+		wantID   string
+		wantErr  error
+	}{
+		{nameOrID: "my-kafka", wantID: "1iSY6RQ3JKI8Q0OTmjQFd3ocFRg"},
+		{nameOrID: "1iSY6RQ3JKI8Q0OTmjQFd3ocFRg", wantID: "1iSY6RQ3JKI8Q0OTmjQFd3ocFRg"},
+		// IDs can also be valid names
+		{nameOrID: "c3bm8v7jn1j1n6a5o0hg", wantID: "c3bm8v7jn1j1n6a5o0hg"},
+		{nameOrID: "unknown", wantErr: kafkaerr.NotFoundByNameError("unknown")},
+		{nameOrID: "1UNKNOWN", wantErr: kafkaerr.NotFoundByIDError("1UNKNOWN")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.nameOrID, func(t *testing.T) {
+			k, _, err := GetKafkaByNameOrID(context.Background(), api, tt.nameOrID)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("GetKafkaByNameOrID() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || k.GetId() != tt.wantID {
+				t.Errorf("GetKafkaByNameOrID() = %v, %v, want %v", k.GetId(), err, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Package dataplane provides access to the records of a Kafka instance
+// using the Kafka protocol
+package dataplane
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+)
+
+const dialTimeout = 30 * time.Second
+
+// Config contains the details needed to connect to the bootstrap server of a Kafka instance
+type Config struct {
+	BootstrapServer string
+	// AccessToken is used to authenticate with the OAUTHBEARER SASL mechanism
+	AccessToken string
+}
+
+// Header is a single record header
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Record is a single record of a Kafka topic
+type Record struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Time      time.Time
+}
+
+// PartitionOffsets contains the range of offsets which are available in a partition.
+// Last is the offset of the next record which will be written to the partition.
+type PartitionOffsets struct {
+	Partition int
+	First     int64
+	Last      int64
+}
+
+func (c *Config) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+func (c *Config) saslMechanism() sasl.Mechanism {
+	return &oauthBearer{token: c.AccessToken}
+}
+
+func (c *Config) dialer() *kafka.Dialer {
+	return &kafka.Dialer{
+		Timeout:       dialTimeout,
+		DualStack:     true,
+		TLS:           c.tlsConfig(),
+		SASLMechanism: c.saslMechanism(),
+	}
+}
+
+func (c *Config) transport() *kafka.Transport {
+	return &kafka.Transport{
+		DialTimeout: dialTimeout,
+		TLS:         c.tlsConfig(),
+		SASL:        c.saslMechanism(),
+	}
+}
+
+// oauthBearer implements the OAUTHBEARER SASL mechanism
+type oauthBearer struct {
+	token string
+}
+
+func (m *oauthBearer) Name() string {
+	return "OAUTHBEARER"
+}
+
+func (m *oauthBearer) Start(ctx context.Context) (sasl.StateMachine, []byte, error) {
+	return m, []byte("n,,\x01auth=Bearer " + m.token + "\x01\x01"), nil
+}
+
+func (m *oauthBearer) Next(ctx context.Context, challenge []byte) (bool, []byte, error) {
+	// a challenge is only sent when the authentication failed,
+	// answering it lets the server report the error
+	if len(challenge) > 0 {
+		return false, []byte("\x01"), nil
+	}
+	return true, nil, nil
+}
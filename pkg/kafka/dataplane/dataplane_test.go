@@ -0,0 +1,16 @@
+package dataplane
+
+import "testing"
+
+func TestConfigDialer(t *testing.T) {
+	for _, bootstrapServer := range []string{"localhost:9092", "127.0.0.1:9092", "my-kafka.kafka.rhcloud.com:443"} {
+		c := &Config{BootstrapServer: bootstrapServer, AccessToken: "token"}
+		dialer := c.dialer()
+		if dialer.TLS == nil {
+			t.Errorf("dialer for %v does not use TLS", bootstrapServer)
+		}
+		if dialer.SASLMechanism == nil || dialer.SASLMechanism.Name() != "OAUTHBEARER" {
+			t.Errorf("dialer for %v does not use the OAUTHBEARER SASL mechanism", bootstrapServer)
+		}
+	}
+}
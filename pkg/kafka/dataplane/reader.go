@@ -0,0 +1,161 @@
+package dataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// fetchTimeout is how long Read waits for the next record before it considers that there are no more records before its end
+const fetchTimeout = 10 * time.Second
+
+// Reader reads the records of a single topic, one partition at a time.
+// Offsets are not committed to a consumer group.
+type Reader struct {
+	config  *Config
+	topic   string
+	readers map[int]*kafka.Reader
+}
+
+// NewReader creates a reader for a topic
+func NewReader(config *Config, topic string) *Reader {
+	return &Reader{
+		config:  config,
+		topic:   topic,
+		readers: map[int]*kafka.Reader{},
+	}
+}
+
+// Offsets returns the available offsets of every partition of the topic
+func (r *Reader) Offsets(ctx context.Context) ([]PartitionOffsets, error) {
+	partitions, err := r.config.dialer().LookupPartitions(ctx, "tcp", r.config.BootstrapServer, r.topic)
+	if err != nil {
+		return nil, err
+	}
+	if len(partitions) == 0 {
+		return nil, fmt.Errorf("topic %q not found", r.topic)
+	}
+
+	offsets := []PartitionOffsets{}
+	for _, p := range partitions {
+		conn, err := r.dialLeader(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		first, last, err := conn.ReadOffsets()
+		_ = conn.Close()
+		if err != nil {
+			return nil, err
+		}
+		offsets = append(offsets, PartitionOffsets{
+			Partition: p.ID,
+			First:     first,
+			Last:      last,
+		})
+	}
+
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i].Partition < offsets[j].Partition
+	})
+
+	return offsets, nil
+}
+
+// OffsetAt returns the offset of the first record of a partition written at or after t.
+// When there is no such record, it returns the offset of the next record which will be written.
+func (r *Reader) OffsetAt(ctx context.Context, partition int, t time.Time) (int64, error) {
+	conn, err := r.dialLeader(ctx, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	offset, err := conn.ReadOffset(t)
+	if err != nil {
+		return 0, err
+	}
+	if offset < 0 {
+		return conn.ReadLastOffset()
+	}
+	return offset, nil
+}
+
+func (r *Reader) dialLeader(ctx context.Context, partition int) (*kafka.Conn, error) {
+	return r.config.dialer().DialLeader(ctx, "tcp", r.config.BootstrapServer, r.topic, partition)
+}
+
+// Read returns the records of a partition starting at offset and stopping before end.
+// The caller must make sure that the offsets up to end are available. Offsets which hold no records,
+// such as transaction markers or the gaps left by compaction, are never fetched, so Read stops
+// when no record is fetched within fetchTimeout.
+func (r *Reader) Read(ctx context.Context, partition int, offset int64, end int64) ([]Record, error) {
+	reader, ok := r.readers[partition]
+	if !ok {
+		reader = kafka.NewReader(kafka.ReaderConfig{
+			Brokers:   []string{r.config.BootstrapServer},
+			Topic:     r.topic,
+			Partition: partition,
+			Dialer:    r.config.dialer(),
+			MaxBytes:  10e6,
+		})
+		r.readers[partition] = reader
+	}
+
+	if reader.Offset() != offset {
+		if err := reader.SetOffset(offset); err != nil {
+			return nil, err
+		}
+	}
+
+	records := []Record{}
+	for reader.Offset() < end {
+		fetchCtx, cancel := context.WithTimeout(ctx, fetchTimeout)
+		msg, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+				return records, nil
+			}
+			return records, err
+		}
+		// the record is past a gap which ends after end, so it is left for the next read
+		if msg.Offset >= end {
+			break
+		}
+		records = append(records, fromMessage(msg))
+	}
+
+	return records, nil
+}
+
+// Close closes the connections of the reader
+func (r *Reader) Close() error {
+	var closeErr error
+	for _, reader := range r.readers {
+		if err := reader.Close(); err != nil {
+			closeErr = err
+		}
+	}
+	return closeErr
+}
+
+func fromMessage(msg kafka.Message) Record {
+	headers := []Header{}
+	for _, h := range msg.Headers {
+		headers = append(headers, Header{Key: h.Key, Value: h.Value})
+	}
+
+	return Record{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Time:      msg.Time,
+	}
+}
@@ -0,0 +1,70 @@
+package dataplane
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Writer writes records to a single topic
+type Writer struct {
+	writer *kafka.Writer
+}
+
+// NewWriter creates a writer for a topic.
+// Records are written to the partition they were read from when the topic has enough partitions,
+// otherwise the partition is chosen from the key. Set the partition of a record to -1 to always
+// choose the partition from the key.
+func NewWriter(config *Config, topic string) *Writer {
+	return &Writer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(config.BootstrapServer),
+			Topic:        topic,
+			Balancer:     &samePartitionBalancer{},
+			RequiredAcks: kafka.RequireAll,
+			Transport:    config.transport(),
+		},
+	}
+}
+
+// Write writes the records and waits until they are acknowledged
+func (w *Writer) Write(ctx context.Context, records []Record) error {
+	messages := make([]kafka.Message, 0, len(records))
+	for _, r := range records {
+		messages = append(messages, toMessage(r))
+	}
+	return w.writer.WriteMessages(ctx, messages...)
+}
+
+// Close flushes and closes the writer
+func (w *Writer) Close() error {
+	return w.writer.Close()
+}
+
+func toMessage(r Record) kafka.Message {
+	headers := []kafka.Header{}
+	for _, h := range r.Headers {
+		headers = append(headers, kafka.Header{Key: h.Key, Value: h.Value})
+	}
+
+	return kafka.Message{
+		Partition: r.Partition,
+		Key:       r.Key,
+		Value:     r.Value,
+		Headers:   headers,
+		Time:      r.Time,
+	}
+}
+
+type samePartitionBalancer struct {
+	hash kafka.Hash
+}
+
+func (b *samePartitionBalancer) Balance(msg kafka.Message, partitions ...int) int {
+	for _, p := range partitions {
+		if p == msg.Partition {
+			return p
+		}
+	}
+	return b.hash.Balance(msg, partitions...)
+}
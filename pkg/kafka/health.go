@@ -0,0 +1,124 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-cli/pkg/common/age"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// maxConcurrentHealthChecks is the highest number of admin servers checked at the same time
+const maxConcurrentHealthChecks = 10
+
+const (
+	statusReady  = "ready"
+	statusFailed = "failed"
+)
+
+// Reachability of the admin server of a Kafka instance
+const (
+	AdminReachable   = "reachable"
+	AdminUnreachable = "unreachable"
+)
+
+// InstanceHealth is the health of a Kafka instance displayed by "rhoas status --all"
+type InstanceHealth struct {
+	ID           string `json:"id" yaml:"id" header:"ID"`
+	Name         string `json:"name" yaml:"name" header:"Name"`
+	Status       string `json:"status" yaml:"status" header:"Status"`
+	FailedReason string `json:"failed_reason,omitempty" yaml:"failed_reason,omitempty" header:"Failed Reason"`
+	// Admin is the reachability of the admin server, which is only checked when the instance is ready
+	Admin      string `json:"admin,omitempty" yaml:"admin,omitempty" header:"Admin API"`
+	AdminError string `json:"admin_error,omitempty" yaml:"admin_error,omitempty" header:"Admin Error"`
+	Age        string `json:"age" yaml:"age" header:"Age"`
+	Healthy    bool   `json:"healthy" yaml:"healthy"`
+}
+
+// GetHealth returns the health of all the Kafka instances the user can see, in the order they are listed.
+// An instance is unhealthy when it failed, or when it is ready but its admin server cannot be reached.
+// The admin servers are checked concurrently.
+func GetHealth(ctx context.Context, api *api.API) ([]InstanceHealth, error) {
+	instances, err := ListAll(ctx, api.Kafka(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	health := make([]InstanceHealth, len(instances))
+	limit := make(chan struct{}, maxConcurrentHealthChecks)
+	var wg sync.WaitGroup
+	for i, k := range instances {
+		health[i] = InstanceHealth{
+			ID:      k.GetId(),
+			Name:    k.GetName(),
+			Status:  k.GetStatus(),
+			Age:     age.Since(k.GetCreatedAt()),
+			Healthy: k.GetStatus() != statusFailed,
+		}
+		if k.GetStatus() == statusFailed {
+			health[i].FailedReason = k.GetFailedReason()
+		}
+		if k.GetStatus() != statusReady {
+			continue
+		}
+
+		wg.Add(1)
+		go func(h *InstanceHealth) {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			if err := checkAdmin(ctx, api, h.ID); err != nil {
+				h.Admin = AdminUnreachable
+				h.AdminError = err.Error()
+				h.Healthy = false
+				return
+			}
+			h.Admin = AdminReachable
+		}(&health[i])
+	}
+	wg.Wait()
+
+	return health, nil
+}
+
+// ListAll returns all the Kafka instances which match the search query, or all of them when it is empty,
+// requesting them page by page
+func ListAll(ctx context.Context, api kafkamgmtclient.DefaultApi, search string) ([]kafkamgmtclient.KafkaRequest, error) {
+	instances := []kafkamgmtclient.KafkaRequest{}
+	for page := 1; ; page++ {
+		r := api.GetKafkas(ctx).
+			Page(strconv.Itoa(page)).
+			Size(queryLimit)
+		if search != "" {
+			r = r.Search(search)
+		}
+
+		list, _, err := r.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, list.GetItems()...)
+		if len(list.GetItems()) == 0 || len(instances) >= int(list.GetTotal()) {
+			return instances, nil
+		}
+	}
+}
+
+// checkAdmin makes a lightweight request to the admin server of the Kafka instance with the given ID
+func checkAdmin(ctx context.Context, api *api.API, id string) error {
+	adminAPI, _, err := api.KafkaAdmin(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	_, _, err = adminAPI.GetTopics(ctx).Limit(1).Execute()
+	return err
+}
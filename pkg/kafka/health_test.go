@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+	"github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestGetHealth(t *testing.T) {
+	newInstance := func(id string, status string, failedReason string) kafkamgmtclient.KafkaRequest {
+		k := newKafka(id, id+"-kafka")
+		k.SetStatus(status)
+		if failedReason != "" {
+			k.SetFailedReason(failedReason)
+		}
+		return k
+	}
+	instances := []kafkamgmtclient.KafkaRequest{
+		newInstance("reachable", "ready", ""),
+		newInstance("unreachable", "ready", ""),
+		newInstance("provisioning", "provisioning", ""),
+		newInstance("failed", "failed", "insufficient capacity"),
+	}
+
+	// the instances are served two per page
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		page := 0
+		if r.URL.Query().Get("page") == "2" {
+			page = 1
+		}
+		items := instances[page*2 : page*2+2]
+		_ = json.NewEncoder(w).Encode(kafkamgmtclient.KafkaRequestList{Items: items, Page: int32(page + 1), Size: 2, Total: int32(len(instances))})
+	}))
+	t.Cleanup(server.Close)
+
+	adminAPI := &kafkainstanceclient.DefaultApiMock{}
+	adminAPI.GetTopicsFunc = func(ctx context.Context) kafkainstanceclient.ApiGetTopicsRequest {
+		return kafkainstanceclient.ApiGetTopicsRequest{ApiService: adminAPI}
+	}
+	adminAPI.GetTopicsExecuteFunc = func(kafkainstanceclient.ApiGetTopicsRequest) (kafkainstanceclient.TopicsList, *http.Response, error) {
+		return kafkainstanceclient.TopicsList{}, nil, nil
+	}
+
+	health, err := GetHealth(context.Background(), &api.API{
+		Kafka: func() kafkamgmtclient.DefaultApi {
+			return kafkamgmt.NewAPIClient(&kafkamgmt.Config{BaseURL: server.URL}).DefaultApi
+		},
+		KafkaAdmin: func(kafkaID string) (kafkainstanceclient.DefaultApi, *kafkamgmtclient.KafkaRequest, error) {
+			if kafkaID == "unreachable" {
+				return nil, nil, errors.New("connection refused")
+			}
+			return adminAPI, nil, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []InstanceHealth{
+		{ID: "reachable", Status: "ready", Admin: AdminReachable, Healthy: true},
+		{ID: "unreachable", Status: "ready", Admin: AdminUnreachable, AdminError: "connection refused"},
+		{ID: "provisioning", Status: "provisioning", Healthy: true},
+		{ID: "failed", Status: "failed", FailedReason: "insufficient capacity"},
+	}
+	if len(health) != len(want) {
+		t.Fatalf("GetHealth() returned %v instances, want %v", len(health), len(want))
+	}
+	for i, h := range health {
+		w := want[i]
+		w.Name = w.ID + "-kafka"
+		w.Age = h.Age
+		if h != w {
+			t.Errorf("GetHealth()[%v] = %+v, want %+v", i, h, w)
+		}
+	}
+
+	// only the ready instances have their admin server checked
+	if n := len(adminAPI.GetTopicsExecuteCalls()); n != 1 {
+		t.Errorf("admin server checked %v times, want 1", n)
+	}
+}
@@ -0,0 +1,95 @@
+package kafka
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/redhat-developer/app-services-cli/pkg/cache"
+)
+
+// IDCache stores the IDs of Kafka instances by the name or ID used to select them,
+// so that selecting an instance by name does not require looking it up every time
+type IDCache struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewIDCache creates a cache stored in the file at path
+func NewIDCache(path string) *IDCache {
+	return &IDCache{path: path}
+}
+
+// DefaultIDCache returns the cache stored in the cache directory of the CLI
+func DefaultIDCache() (*IDCache, error) {
+	cacheDir, err := cache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	return NewIDCache(filepath.Join(cacheDir, "kafka_ids.json")), nil
+}
+
+// Get returns the cached ID of the Kafka instance selected by nameOrID on the API at apiURL
+func (c *IDCache) Get(apiURL string, nameOrID string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id, ok := c.load()[apiURL][nameOrID]
+	return id, ok
+}
+
+// Set caches the ID of the Kafka instance selected by nameOrID on the API at apiURL
+func (c *IDCache) Set(apiURL string, nameOrID string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := c.load()
+	if ids[apiURL] == nil {
+		ids[apiURL] = map[string]string{}
+	}
+	ids[apiURL][nameOrID] = id
+
+	return c.save(ids)
+}
+
+// Remove removes the cached ID of the Kafka instance selected by nameOrID on the API at apiURL
+func (c *IDCache) Remove(apiURL string, nameOrID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ids := c.load()
+	if _, ok := ids[apiURL][nameOrID]; !ok {
+		return nil
+	}
+	delete(ids[apiURL], nameOrID)
+
+	return c.save(ids)
+}
+
+// load reads the cache file, an unreadable cache is treated as empty
+func (c *IDCache) load() map[string]map[string]string {
+	ids := map[string]map[string]string{}
+
+	data, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		return ids
+	}
+	if err = json.Unmarshal(data, &ids); err != nil {
+		return map[string]map[string]string{}
+	}
+
+	return ids
+}
+
+func (c *IDCache) save(ids map[string]map[string]string) error {
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, data, 0600)
+}
@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIDCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rhoas", "kafka_ids.json")
+	cache := NewIDCache(path)
+
+	if _, ok := cache.Get("https://api.openshift.com", "my-kafka"); ok {
+		t.Error("Get() found an ID in an empty cache")
+	}
+
+	if err = cache.Set("https://api.openshift.com", "my-kafka", "prod-id"); err != nil {
+		t.Fatal(err)
+	}
+	if err = cache.Set("https://api.stage.openshift.com", "my-kafka", "stage-id"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the cache is shared by all the commands
+	cache = NewIDCache(path)
+	if id, ok := cache.Get("https://api.openshift.com", "my-kafka"); !ok || id != "prod-id" {
+		t.Errorf("Get() = %v, %v", id, ok)
+	}
+	if id, ok := cache.Get("https://api.stage.openshift.com", "my-kafka"); !ok || id != "stage-id" {
+		t.Errorf("Get() = %v, %v", id, ok)
+	}
+
+	if err = cache.Remove("https://api.openshift.com", "my-kafka"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("https://api.openshift.com", "my-kafka"); ok {
+		t.Error("Get() found a removed ID")
+	}
+
+	// a corrupted cache is treated as empty
+	if err = ioutil.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("https://api.stage.openshift.com", "my-kafka"); ok {
+		t.Error("Get() found an ID in a corrupted cache")
+	}
+	if err = cache.Set("https://api.openshift.com", "my-kafka", "prod-id"); err != nil {
+		t.Errorf("Set() could not replace a corrupted cache: %v", err)
+	}
+}
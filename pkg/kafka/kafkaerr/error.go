@@ -1,6 +1,7 @@
 package kafkaerr
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -11,11 +12,29 @@ var (
 	InvalidNameErr          error
 )
 
+// InstanceNotFoundError is returned when no Kafka instance exists with the given ID
+type InstanceNotFoundError struct {
+	ID string
+}
+
+func (e *InstanceNotFoundError) Error() string {
+	return fmt.Sprintf(`Kafka instance with ID "%v" not found`, e.ID)
+}
+
 func NotFoundByIDError(id string) error {
-	NotFoundByIDErr = fmt.Errorf(`Kafka instance with ID "%v" not found`, id)
+	NotFoundByIDErr = &InstanceNotFoundError{ID: id}
 	return NotFoundByIDErr
 }
 
+// NotFoundID returns the ID of the Kafka instance which was not found when err is a NotFoundByIDError
+func NotFoundID(err error) (string, bool) {
+	var notFoundErr *InstanceNotFoundError
+	if !errors.As(err, &notFoundErr) {
+		return "", false
+	}
+	return notFoundErr.ID, true
+}
+
 func NotFoundByNameError(name string) error {
 	NotFoundByNameErr = fmt.Errorf(`Kafka instance "%v" not found`, name)
 	return NotFoundByNameErr
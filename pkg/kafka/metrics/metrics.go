@@ -0,0 +1,274 @@
+// Package metrics reads the metrics of Kafka instances from the Kafka management API
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+const (
+	// MaxRange is the longest time range the metrics can be queried for
+	MaxRange = 4320 * time.Minute
+	// MaxInterval is the longest interval between the data points of the metrics
+	MaxInterval = 10800 * time.Second
+)
+
+// Metric is a metric of a Kafka instance, computed from a metric of its brokers
+type Metric struct {
+	// Name is the name of the metric displayed to the user
+	Name string
+	// Source is the name of the Kafka metric it is computed from, which is summed over all its series
+	Source string
+	// Counter is true if the source metric is a counter, whose rate per second is reported
+	Counter bool
+	// Unit is the unit of the values of the metric
+	Unit string
+}
+
+// Metrics are the metrics reported for a Kafka instance
+var Metrics = []Metric{
+	{Name: "bytes_in", Source: "kafka_server_brokertopicmetrics_bytes_in_total", Counter: true, Unit: "bytes/s"},
+	{Name: "bytes_out", Source: "kafka_server_brokertopicmetrics_bytes_out_total", Counter: true, Unit: "bytes/s"},
+	{Name: "messages_in", Source: "kafka_server_brokertopicmetrics_messages_in_total", Counter: true, Unit: "messages/s"},
+	{Name: "partitions", Source: "kafka_topic:kafka_topic_partitions:sum", Unit: "partitions"},
+	{Name: "consumer_lag", Source: "kafka_consumergroup_lag", Unit: "messages"},
+	{Name: "disk_used", Source: "kubelet_volume_stats_used_bytes", Unit: "bytes"},
+}
+
+// Point is the value of a metric at a point in time
+type Point struct {
+	Time  time.Time `json:"time" yaml:"time"`
+	Value float64   `json:"value" yaml:"value"`
+}
+
+// Series is the values of a metric over a time range
+type Series struct {
+	Name   string  `json:"name" yaml:"name"`
+	Unit   string  `json:"unit" yaml:"unit"`
+	Points []Point `json:"points" yaml:"points"`
+}
+
+// Values returns the values of the series in order of time
+func (s Series) Values() []float64 {
+	values := make([]float64, len(s.Points))
+	for i, p := range s.Points {
+		values[i] = p.Value
+	}
+	return values
+}
+
+// Last returns the latest value of the series, or 0 when it has no values
+func (s Series) Last() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	return s.Points[len(s.Points)-1].Value
+}
+
+// Min returns the lowest value of the series, or 0 when it has no values
+func (s Series) Min() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	min := math.Inf(1)
+	for _, p := range s.Points {
+		min = math.Min(min, p.Value)
+	}
+	return min
+}
+
+// Max returns the highest value of the series, or 0 when it has no values
+func (s Series) Max() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	max := math.Inf(-1)
+	for _, p := range s.Points {
+		max = math.Max(max, p.Value)
+	}
+	return max
+}
+
+// sources returns the names of the Kafka metrics the metrics are computed from
+func sources() []string {
+	names := []string{}
+	for _, m := range Metrics {
+		names = append(names, m.Source)
+	}
+	return names
+}
+
+// GetRange returns the metrics of the Kafka instance with the given ID over the last timeRange,
+// with a data point at every interval. The metrics are returned in the order of Metrics.
+func GetRange(ctx context.Context, api kafkamgmtclient.DefaultApi, kafkaID string, timeRange time.Duration, interval time.Duration) ([]Series, error) {
+	result, _, err := api.GetMetricsByRangeQuery(ctx, kafkaID).
+		Duration(int64(timeRange / time.Minute)).
+		Interval(int64(interval / time.Second)).
+		Filters(sources()).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	// the points of each series of every source metric
+	seriesBySource := map[string][][]Point{}
+	for _, item := range result.GetItems() {
+		name := item.GetMetric()["__name__"]
+		values := map[int64]float64{}
+		for _, v := range item.GetValues() {
+			values[v.GetTimestamp()] = v.Value
+		}
+		seriesBySource[name] = append(seriesBySource[name], toPoints(values))
+	}
+
+	series := []Series{}
+	for _, m := range Metrics {
+		series = append(series, Series{Name: m.Name, Unit: m.Unit, Points: sum(seriesBySource[m.Source], m.Counter)})
+	}
+
+	return series, nil
+}
+
+// sum returns the sum of the series at each point in time.
+// The rates of counters are computed for each series before they are summed,
+// so that a counter which is reset or a series which appears does not make the sum jump.
+func sum(series [][]Point, counter bool) []Point {
+	sums := map[time.Time]float64{}
+	for _, points := range series {
+		if counter {
+			points = rates(points)
+		}
+		for _, p := range points {
+			sums[p.Time] += p.Value
+		}
+	}
+
+	points := []Point{}
+	for t, value := range sums {
+		points = append(points, Point{Time: t, Value: value})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	return points
+}
+
+// toPoints returns the values by timestamp in milliseconds as points in order of time
+func toPoints(values map[int64]float64) []Point {
+	points := []Point{}
+	for timestamp, value := range values {
+		points = append(points, Point{Time: time.Unix(0, timestamp*int64(time.Millisecond)).UTC(), Value: value})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	return points
+}
+
+// rates returns the rate per second of a counter between each of its points and the previous one.
+// When the counter was reset, its value is counted from zero.
+func rates(points []Point) []Point {
+	rates := []Point{}
+	for i := 1; i < len(points); i++ {
+		seconds := points[i].Time.Sub(points[i-1].Time).Seconds()
+		if seconds <= 0 {
+			continue
+		}
+		increase := points[i].Value - points[i-1].Value
+		if increase < 0 {
+			increase = points[i].Value
+		}
+		rates = append(rates, Point{Time: points[i].Time, Value: increase / seconds})
+	}
+	return rates
+}
+
+// GetInstant returns the latest values of all the series of the Kafka metrics the metrics are computed from
+func GetInstant(ctx context.Context, api kafkamgmtclient.DefaultApi, kafkaID string) ([]kafkamgmtclient.InstantQuery, error) {
+	result, _, err := api.GetMetricsByInstantQuery(ctx, kafkaID).
+		Filters(sources()).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return result.GetItems(), nil
+}
+
+// WritePrometheus writes samples in the Prometheus text exposition format,
+// grouped by metric in the order of Metrics
+func WritePrometheus(w io.Writer, samples []kafkamgmtclient.InstantQuery) error {
+	for _, m := range Metrics {
+		metricType := "gauge"
+		if m.Counter {
+			metricType = "counter"
+		}
+
+		written := false
+		for _, s := range samples {
+			labels := s.GetMetric()
+			if labels["__name__"] != m.Source {
+				continue
+			}
+
+			if !written {
+				if _, err := fmt.Fprintf(w, "# TYPE %v %v\n", m.Source, metricType); err != nil {
+					return err
+				}
+				written = true
+			}
+
+			line := m.Source + formatLabels(labels) + " " + formatValue(s.Value)
+			if s.Timestamp != nil {
+				line += fmt.Sprintf(" %v", s.GetTimestamp())
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatLabels formats labels as a Prometheus label set sorted by name, leaving out the name of the metric
+func formatLabels(labels map[string]string) string {
+	names := []string{}
+	for name := range labels {
+		if name != "__name__" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+
+	pairs := []string{}
+	for _, name := range names {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labels[name])
+		pairs = append(pairs, fmt.Sprintf(`%v="%v"`, name, value))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// formatValue formats a value as a Prometheus sample value
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
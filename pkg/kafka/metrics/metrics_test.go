@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// newMetricsAPI serves the given range and instant query results from a fake Kafka management API
+func newMetricsAPI(t *testing.T, rangeItems []kafkamgmtclient.RangeQuery, instantItems []kafkamgmtclient.InstantQuery) kafkamgmtclient.DefaultApi {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/kafkas_mgmt/v1/kafkas/my-kafka/metrics/query_range":
+			if r.URL.Query().Get("duration") != "60" || r.URL.Query().Get("interval") != "300" {
+				t.Errorf("unexpected query %v", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(kafkamgmtclient.MetricsRangeQueryList{Items: &rangeItems})
+		case "/api/kafkas_mgmt/v1/kafkas/my-kafka/metrics/query":
+			_ = json.NewEncoder(w).Encode(kafkamgmtclient.MetricsInstantQueryList{Items: &instantItems})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return kafkamgmt.NewAPIClient(&kafkamgmt.Config{BaseURL: server.URL}).DefaultApi
+}
+
+func newRangeQuery(labels map[string]string, values ...float64) kafkamgmtclient.RangeQuery {
+	items := []kafkamgmtclient.Values{}
+	for i, v := range values {
+		timestamp := int64(i * 300 * 1000)
+		items = append(items, kafkamgmtclient.Values{Timestamp: &timestamp, Value: v})
+	}
+	return kafkamgmtclient.RangeQuery{Metric: &labels, Values: &items}
+}
+
+func TestGetRange(t *testing.T) {
+	api := newMetricsAPI(t, []kafkamgmtclient.RangeQuery{
+		newRangeQuery(map[string]string{"__name__": "kafka_server_brokertopicmetrics_bytes_in_total", "topic": "orders"}, 0, 3000, 6000),
+		newRangeQuery(map[string]string{"__name__": "kafka_server_brokertopicmetrics_bytes_in_total", "topic": "payments"}, 0, 0, 3000),
+		// a counter reset is counted from zero
+		newRangeQuery(map[string]string{"__name__": "kafka_server_brokertopicmetrics_messages_in_total"}, 600, 1200, 300),
+		newRangeQuery(map[string]string{"__name__": "kubelet_volume_stats_used_bytes", "persistentvolumeclaim": "data-0"}, 10, 20, 30),
+		newRangeQuery(map[string]string{"__name__": "kubelet_volume_stats_used_bytes", "persistentvolumeclaim": "data-1"}, 1, 2, 3),
+	}, nil)
+
+	series, err := GetRange(context.Background(), api, "my-kafka", time.Hour, 5*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series) != len(Metrics) {
+		t.Fatalf("GetRange() returned %v series, want %v", len(series), len(Metrics))
+	}
+
+	values := map[string][]float64{}
+	for _, s := range series {
+		values[s.Name] = s.Values()
+	}
+
+	want := map[string][]float64{
+		"bytes_in":     {10, 20},
+		"bytes_out":    {},
+		"messages_in":  {2, 1},
+		"partitions":   {},
+		"consumer_lag": {},
+		"disk_used":    {11, 22, 33},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("GetRange() values = %v, want %v", values, want)
+	}
+
+	if s := series[0]; s.Last() != 20 || s.Min() != 10 || s.Max() != 20 {
+		t.Errorf("last, min, max = %v, %v, %v", s.Last(), s.Min(), s.Max())
+	}
+}
+
+func TestGetRangeCounterReset(t *testing.T) {
+	bytesIn := map[string]string{"__name__": "kafka_server_brokertopicmetrics_bytes_in_total"}
+	withTopic := func(topic string) map[string]string {
+		labels := map[string]string{"topic": topic}
+		for k, v := range bytesIn {
+			labels[k] = v
+		}
+		return labels
+	}
+
+	// a series which appears later only starts contributing once it has a rate
+	appearing := newRangeQuery(withTopic("new"), 0, 0, 90000)
+	appearingValues := (*appearing.Values)[2:]
+	appearing.Values = &appearingValues
+
+	api := newMetricsAPI(t, []kafkamgmtclient.RangeQuery{
+		newRangeQuery(withTopic("orders"), 0, 3000, 6000),
+		// only this series is reset
+		newRangeQuery(withTopic("payments"), 9000, 12000, 300),
+		appearing,
+	}, nil)
+
+	series, err := GetRange(context.Background(), api, "my-kafka", time.Hour, 5*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := series[0].Values(), []float64{20, 11}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bytes_in values = %v, want %v", got, want)
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	timestamp := int64(1622548800000)
+	api := newMetricsAPI(t, nil, []kafkamgmtclient.InstantQuery{
+		{Metric: &map[string]string{"__name__": "kubelet_volume_stats_used_bytes", "persistentvolumeclaim": "data-0"}, Timestamp: &timestamp, Value: 1024},
+		{Metric: &map[string]string{"__name__": "kafka_server_brokertopicmetrics_bytes_in_total", "topic": "orders", "broker": "0"}, Timestamp: &timestamp, Value: 3000},
+	})
+
+	samples, err := GetInstant(context.Background(), api, "my-kafka")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err = WritePrometheus(&out, samples); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# TYPE kafka_server_brokertopicmetrics_bytes_in_total counter
+kafka_server_brokertopicmetrics_bytes_in_total{broker="0",topic="orders"} 3000 1622548800000
+# TYPE kubelet_volume_stats_used_bytes gauge
+kubelet_volume_stats_used_bytes{persistentvolumeclaim="data-0"} 1024 1622548800000
+`
+	if out.String() != want {
+		t.Errorf("WritePrometheus() =\n%v\nwant\n%v", out.String(), want)
+	}
+}
@@ -0,0 +1,281 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccount/credentials"
+	"gopkg.in/yaml.v2"
+)
+
+// FederatePath returns the path of the endpoint which exposes the metrics of a Kafka instance for Prometheus federation
+func FederatePath(kafkaID string) string {
+	return fmt.Sprintf("/api/kafkas_mgmt/v1/kafkas/%v/metrics/federate", url.PathEscape(kafkaID))
+}
+
+// ScrapeTarget is the federation endpoint of a Kafka instance and the service account Prometheus authenticates with
+type ScrapeTarget struct {
+	KafkaID   string
+	KafkaName string
+	// APIURL is the URL of the API which serves the federation endpoint
+	APIURL string
+	// TokenURL is the URL from which access tokens of the service account are requested
+	TokenURL    string
+	Credentials credentials.Credentials
+}
+
+// jobName returns the name of the Prometheus job which scrapes the target
+func (t ScrapeTarget) jobName() string {
+	return "rhoas-kafka-" + t.KafkaName
+}
+
+type scrapeConfigFile struct {
+	ScrapeConfigs []scrapeConfig `yaml:"scrape_configs"`
+}
+
+type scrapeConfig struct {
+	JobName       string         `yaml:"job_name"`
+	Scheme        string         `yaml:"scheme"`
+	MetricsPath   string         `yaml:"metrics_path"`
+	HonorLabels   bool           `yaml:"honor_labels"`
+	OAuth2        oauth2         `yaml:"oauth2"`
+	StaticConfigs []staticConfig `yaml:"static_configs"`
+}
+
+type oauth2 struct {
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	TokenURL     string `yaml:"token_url"`
+}
+
+type staticConfig struct {
+	Targets []string `yaml:"targets"`
+}
+
+// ScrapeConfig returns a Prometheus configuration with a scrape config for the target
+func ScrapeConfig(t ScrapeTarget) ([]byte, error) {
+	apiURL, err := url.Parse(t.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(scrapeConfigFile{
+		ScrapeConfigs: []scrapeConfig{
+			{
+				JobName:     t.jobName(),
+				Scheme:      apiURL.Scheme,
+				MetricsPath: strings.TrimSuffix(apiURL.Path, "/") + FederatePath(t.KafkaID),
+				// the metrics keep the labels of the Kafka instance
+				HonorLabels: true,
+				OAuth2: oauth2{
+					ClientID:     t.Credentials.ClientID,
+					ClientSecret: t.Credentials.ClientSecret,
+					TokenURL:     t.TokenURL,
+				},
+				StaticConfigs: []staticConfig{{Targets: []string{apiURL.Host}}},
+			},
+		},
+	})
+}
+
+// Secret and ServiceMonitor keys of the client ID and secret of the service account
+const (
+	clientIDKey     = "client-id"
+	clientSecretKey = "client-secret"
+)
+
+type objectMeta struct {
+	Name      string            `yaml:"name"`
+	Namespace string            `yaml:"namespace,omitempty"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+}
+
+type secret struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   objectMeta        `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	StringData map[string]string `yaml:"stringData"`
+}
+
+type service struct {
+	APIVersion string      `yaml:"apiVersion"`
+	Kind       string      `yaml:"kind"`
+	Metadata   objectMeta  `yaml:"metadata"`
+	Spec       serviceSpec `yaml:"spec"`
+}
+
+// serviceSpec has no selector, so that its endpoints are the ones listed in the Endpoints of the same name
+type serviceSpec struct {
+	ClusterIP string        `yaml:"clusterIP"`
+	Ports     []servicePort `yaml:"ports"`
+}
+
+type servicePort struct {
+	Name string `yaml:"name"`
+	Port int    `yaml:"port"`
+}
+
+type endpoints struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Metadata   objectMeta       `yaml:"metadata"`
+	Subsets    []endpointSubset `yaml:"subsets"`
+}
+
+type endpointSubset struct {
+	Addresses []endpointAddress `yaml:"addresses"`
+	Ports     []servicePort     `yaml:"ports"`
+}
+
+type endpointAddress struct {
+	IP string `yaml:"ip"`
+}
+
+type serviceMonitor struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   objectMeta         `yaml:"metadata"`
+	Spec       serviceMonitorSpec `yaml:"spec"`
+}
+
+type serviceMonitorSpec struct {
+	Selector  labelSelector `yaml:"selector"`
+	Endpoints []endpoint    `yaml:"endpoints"`
+}
+
+type labelSelector struct {
+	MatchLabels map[string]string `yaml:"matchLabels"`
+}
+
+type endpoint struct {
+	Port        string          `yaml:"port"`
+	Scheme      string          `yaml:"scheme"`
+	Path        string          `yaml:"path"`
+	HonorLabels bool            `yaml:"honorLabels"`
+	OAuth2      monitorOAuth2   `yaml:"oauth2"`
+	Relabelings []relabelConfig `yaml:"relabelings"`
+}
+
+type monitorOAuth2 struct {
+	ClientID     secretValue  `yaml:"clientId"`
+	ClientSecret secretKeyRef `yaml:"clientSecret"`
+	TokenURL     string       `yaml:"tokenUrl"`
+}
+
+type secretValue struct {
+	Secret secretKeyRef `yaml:"secret"`
+}
+
+type secretKeyRef struct {
+	Name string `yaml:"name"`
+	Key  string `yaml:"key"`
+}
+
+type relabelConfig struct {
+	TargetLabel string `yaml:"targetLabel"`
+	Replacement string `yaml:"replacement"`
+}
+
+// KafkaIDLabel is the label of the Service the ServiceMonitor of a Kafka instance selects
+const KafkaIDLabel = "rhoas.redhat.com/kafka-id"
+
+const (
+	// metricsPortName is the name of the port of the Service which the ServiceMonitor scrapes
+	metricsPortName = "metrics"
+	// placeholderIP is the address of the Endpoints of the Service. Prometheus never connects to it,
+	// as the ServiceMonitor replaces it with the host of the API, but Kubernetes only accepts IP addresses in Endpoints.
+	// It is reserved for documentation by RFC 5737.
+	placeholderIP = "192.0.2.1"
+)
+
+// ServiceMonitor returns the Kubernetes manifests of a Secret holding the credentials of the service account,
+// of a Service without selector and its Endpoints, and of a ServiceMonitor which scrapes the target with the credentials.
+// The ServiceMonitor discovers the target through the Service, which is labelled with the ID of the Kafka instance,
+// and replaces the address of its endpoint with the host of the API.
+func ServiceMonitor(t ScrapeTarget, namespace string) ([]byte, error) {
+	apiURL, err := url.Parse(t.APIURL)
+	if err != nil {
+		return nil, err
+	}
+
+	portValue := apiURL.Port()
+	if portValue == "" {
+		portValue = "443"
+		if apiURL.Scheme == "http" {
+			portValue = "80"
+		}
+	}
+	port, err := strconv.Atoi(portValue)
+	if err != nil {
+		return nil, err
+	}
+	address := net.JoinHostPort(apiURL.Hostname(), portValue)
+
+	name := t.jobName()
+	labels := map[string]string{KafkaIDLabel: t.KafkaID}
+	ports := []servicePort{{Name: metricsPortName, Port: port}}
+
+	manifests := []interface{}{
+		secret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Metadata:   objectMeta{Name: name, Namespace: namespace},
+			Type:       "Opaque",
+			StringData: map[string]string{
+				clientIDKey:     t.Credentials.ClientID,
+				clientSecretKey: t.Credentials.ClientSecret,
+			},
+		},
+		service{
+			APIVersion: "v1",
+			Kind:       "Service",
+			Metadata:   objectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       serviceSpec{ClusterIP: "None", Ports: ports},
+		},
+		endpoints{
+			APIVersion: "v1",
+			Kind:       "Endpoints",
+			Metadata:   objectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Subsets: []endpointSubset{
+				{Addresses: []endpointAddress{{IP: placeholderIP}}, Ports: ports},
+			},
+		},
+		serviceMonitor{
+			APIVersion: "monitoring.coreos.com/v1",
+			Kind:       "ServiceMonitor",
+			Metadata:   objectMeta{Name: name, Namespace: namespace},
+			Spec: serviceMonitorSpec{
+				Selector: labelSelector{MatchLabels: labels},
+				Endpoints: []endpoint{
+					{
+						Port:        metricsPortName,
+						Scheme:      apiURL.Scheme,
+						Path:        strings.TrimSuffix(apiURL.Path, "/") + FederatePath(t.KafkaID),
+						HonorLabels: true,
+						OAuth2: monitorOAuth2{
+							ClientID:     secretValue{Secret: secretKeyRef{Name: name, Key: clientIDKey}},
+							ClientSecret: secretKeyRef{Name: name, Key: clientSecretKey},
+							TokenURL:     t.TokenURL,
+						},
+						Relabelings: []relabelConfig{{TargetLabel: "__address__", Replacement: address}},
+					},
+				},
+			},
+		},
+	}
+
+	docs := []string{}
+	for _, m := range manifests {
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, string(data))
+	}
+
+	return []byte(strings.Join(docs, "---\n")), nil
+}
@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/serviceaccount/credentials"
+	"gopkg.in/yaml.v2"
+)
+
+var target = ScrapeTarget{
+	KafkaID:     "c3bm8v7jn1j1n6a5o0hg",
+	KafkaName:   "my-kafka",
+	APIURL:      "https://api.openshift.com",
+	TokenURL:    "https://identity.api.openshift.com/auth/realms/rhoas/protocol/openid-connect/token",
+	Credentials: credentials.Credentials{ClientID: "srvc-acct-1", ClientSecret: "secret"},
+}
+
+func TestScrapeConfig(t *testing.T) {
+	data, err := ScrapeConfig(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var file scrapeConfigFile
+	if err = yaml.UnmarshalStrict(data, &file); err != nil {
+		t.Fatalf("invalid scrape config:\n%v\n%v", string(data), err)
+	}
+	if len(file.ScrapeConfigs) != 1 {
+		t.Fatalf("%v scrape configs, want 1", len(file.ScrapeConfigs))
+	}
+
+	c := file.ScrapeConfigs[0]
+	if c.JobName != "rhoas-kafka-my-kafka" || c.Scheme != "https" || c.MetricsPath != "/api/kafkas_mgmt/v1/kafkas/c3bm8v7jn1j1n6a5o0hg/metrics/federate" {
+		t.Errorf("scrape config = %+v", c)
+	}
+	if c.OAuth2.ClientID != "srvc-acct-1" || c.OAuth2.ClientSecret != "secret" || c.OAuth2.TokenURL != target.TokenURL {
+		t.Errorf("oauth2 = %+v", c.OAuth2)
+	}
+	if len(c.StaticConfigs) != 1 || len(c.StaticConfigs[0].Targets) != 1 || c.StaticConfigs[0].Targets[0] != "api.openshift.com" {
+		t.Errorf("static configs = %+v", c.StaticConfigs)
+	}
+}
+
+func TestServiceMonitor(t *testing.T) {
+	data, err := ServiceMonitor(target, "monitoring")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := strings.Split(string(data), "---\n")
+	if len(docs) != 4 {
+		t.Fatalf("%v manifests, want 4:\n%v", len(docs), string(data))
+	}
+
+	var s secret
+	if err = yaml.UnmarshalStrict([]byte(docs[0]), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Kind != "Secret" || s.Metadata.Namespace != "monitoring" || s.StringData[clientIDKey] != "srvc-acct-1" || s.StringData[clientSecretKey] != "secret" {
+		t.Errorf("secret = %+v", s)
+	}
+
+	// the ServiceMonitor discovers its target through the Service and its Endpoints
+	var svc service
+	if err = yaml.UnmarshalStrict([]byte(docs[1]), &svc); err != nil {
+		t.Fatal(err)
+	}
+	if svc.Kind != "Service" || svc.Metadata.Labels[KafkaIDLabel] != target.KafkaID || len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != 443 {
+		t.Errorf("service = %+v", svc)
+	}
+
+	var ep endpoints
+	if err = yaml.UnmarshalStrict([]byte(docs[2]), &ep); err != nil {
+		t.Fatal(err)
+	}
+	if ep.Kind != "Endpoints" || ep.Metadata.Name != svc.Metadata.Name || len(ep.Subsets) != 1 || ep.Subsets[0].Ports[0] != svc.Spec.Ports[0] {
+		t.Errorf("endpoints = %+v", ep)
+	}
+
+	var m serviceMonitor
+	if err = yaml.UnmarshalStrict([]byte(docs[3]), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Kind != "ServiceMonitor" || m.Spec.Selector.MatchLabels[KafkaIDLabel] != target.KafkaID || len(m.Spec.Endpoints) != 1 {
+		t.Fatalf("service monitor = %+v", m)
+	}
+	if m.Spec.Endpoints[0].Port != svc.Spec.Ports[0].Name {
+		t.Errorf("service monitor port = %v, want %v", m.Spec.Endpoints[0].Port, svc.Spec.Ports[0].Name)
+	}
+
+	e := m.Spec.Endpoints[0]
+	if e.OAuth2.ClientID.Secret.Name != s.Metadata.Name || e.OAuth2.ClientSecret.Name != s.Metadata.Name {
+		t.Errorf("service monitor does not reference the secret: %+v", e.OAuth2)
+	}
+	if len(e.Relabelings) != 1 || e.Relabelings[0].Replacement != "api.openshift.com:443" {
+		t.Errorf("relabelings = %+v", e.Relabelings)
+	}
+}
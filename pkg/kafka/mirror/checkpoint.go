@@ -0,0 +1,94 @@
+package mirror
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Checkpoint records how far the records of a topic have been mirrored,
+// so that an interrupted mirror can be resumed
+type Checkpoint struct {
+	SourceKafkaID string `json:"source_kafka_id"`
+	TargetKafkaID string `json:"target_kafka_id"`
+	SourceTopic   string `json:"source_topic"`
+	TargetTopic   string `json:"target_topic"`
+	// Offsets maps each partition to the offset of the next record to mirror
+	Offsets map[string]int64 `json:"offsets"`
+}
+
+// NewCheckpoint creates an empty checkpoint
+func NewCheckpoint(sourceKafkaID, targetKafkaID, sourceTopic, targetTopic string) *Checkpoint {
+	return &Checkpoint{
+		SourceKafkaID: sourceKafkaID,
+		TargetKafkaID: targetKafkaID,
+		SourceTopic:   sourceTopic,
+		TargetTopic:   targetTopic,
+		Offsets:       map[string]int64{},
+	}
+}
+
+// LoadCheckpoint reads a checkpoint file.
+// It returns nil when the file does not exist.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", "unable to read checkpoint file", err)
+	}
+
+	var c Checkpoint
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%v: %w", "unable to parse checkpoint file", err)
+	}
+	if c.Offsets == nil {
+		c.Offsets = map[string]int64{}
+	}
+
+	return &c, nil
+}
+
+// Save writes the checkpoint to a file.
+// The file is replaced atomically so an interruption never leaves a partial checkpoint.
+func (c *Checkpoint) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%v: %w", "unable to marshal checkpoint", err)
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmpFile := path + ".tmp"
+	if err = ioutil.WriteFile(tmpFile, data, 0600); err != nil {
+		return fmt.Errorf("%v: %w", "unable to save checkpoint", err)
+	}
+
+	return os.Rename(tmpFile, path)
+}
+
+// Matches checks if the checkpoint was created for the same mirror
+func (c *Checkpoint) Matches(other *Checkpoint) bool {
+	return c.SourceKafkaID == other.SourceKafkaID &&
+		c.TargetKafkaID == other.TargetKafkaID &&
+		c.SourceTopic == other.SourceTopic &&
+		c.TargetTopic == other.TargetTopic
+}
+
+// Offset returns the offset of the next record to mirror from a partition
+func (c *Checkpoint) Offset(partition int) (int64, bool) {
+	offset, ok := c.Offsets[strconv.Itoa(partition)]
+	return offset, ok
+}
+
+// SetOffset sets the offset of the next record to mirror from a partition
+func (c *Checkpoint) SetOffset(partition int, offset int64) {
+	c.Offsets[strconv.Itoa(partition)] = offset
+}
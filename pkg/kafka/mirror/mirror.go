@@ -0,0 +1,198 @@
+// Package mirror copies the records of a topic from one Kafka instance to another
+package mirror
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+)
+
+const (
+	// DefaultBatchSize is the maximum number of records read and written at once
+	DefaultBatchSize = 500
+	// DefaultPollInterval is how often new records are checked for when following a topic
+	DefaultPollInterval = 5 * time.Second
+)
+
+// Source is the topic from which records are read
+type Source interface {
+	Offsets(ctx context.Context) ([]dataplane.PartitionOffsets, error)
+	Read(ctx context.Context, partition int, offset int64, end int64) ([]dataplane.Record, error)
+}
+
+// Sink is the topic to which records are written
+type Sink interface {
+	Write(ctx context.Context, records []dataplane.Record) error
+}
+
+// Progress describes how many records have been mirrored so far
+type Progress struct {
+	// Mirrored is the number of records mirrored by this run
+	Mirrored int64
+	// Remaining is the number of records known to be left to mirror
+	Remaining int64
+}
+
+// Options configures how records are mirrored
+type Options struct {
+	BatchSize int
+	// RateLimit is the maximum number of records mirrored per second, 0 means unlimited
+	RateLimit int
+	// Follow keeps mirroring new records until ctx is done
+	Follow       bool
+	PollInterval time.Duration
+	// Checkpoint is updated after every batch of records which has been written
+	Checkpoint *Checkpoint
+	// SaveCheckpoint is called every time the checkpoint is updated
+	SaveCheckpoint func(*Checkpoint) error
+	// OnProgress is called every time a batch of records has been written
+	OnProgress func(Progress)
+}
+
+// Run mirrors the records of the source to the sink, starting from the offsets in the checkpoint.
+// Without Follow it stops once the records which existed when it started have been mirrored.
+// Records keep their partition, key, headers and timestamp.
+// nolint:funlen
+func Run(ctx context.Context, source Source, sink Sink, opts *Options) (Progress, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	// keep the bursts within the rate limit
+	if opts.RateLimit > 0 && opts.BatchSize > opts.RateLimit {
+		opts.BatchSize = opts.RateLimit
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.Checkpoint == nil {
+		opts.Checkpoint = NewCheckpoint("", "", "", "")
+	}
+
+	limiter := newRateLimiter(opts.RateLimit)
+	progress := Progress{}
+
+	for {
+		partitions, err := source.Offsets(ctx)
+		if err != nil {
+			return progress, err
+		}
+
+		// start every partition at the checkpoint, unless those records have already been deleted
+		start := map[int]int64{}
+		progress.Remaining = 0
+		for _, p := range partitions {
+			offset, ok := opts.Checkpoint.Offset(p.Partition)
+			if !ok || offset < p.First {
+				offset = p.First
+			}
+			start[p.Partition] = offset
+			if p.Last > offset {
+				progress.Remaining += p.Last - offset
+			}
+		}
+
+		for _, p := range partitions {
+			offset := start[p.Partition]
+			for offset < p.Last {
+				end := offset + int64(opts.BatchSize)
+				if end > p.Last {
+					end = p.Last
+				}
+
+				if err = limiter.wait(ctx, int(end-offset)); err != nil {
+					return progress, ignoreCanceled(err)
+				}
+
+				records, err := source.Read(ctx, p.Partition, offset, end)
+				if err != nil {
+					return progress, ignoreCanceled(err)
+				}
+				if len(records) == 0 {
+					break
+				}
+				if err = sink.Write(ctx, records); err != nil {
+					return progress, ignoreCanceled(err)
+				}
+
+				next := records[len(records)-1].Offset + 1
+				// offsets can have gaps, for example after compaction
+				progress.Remaining -= next - offset
+				progress.Mirrored += int64(len(records))
+				offset = next
+
+				opts.Checkpoint.SetOffset(p.Partition, offset)
+				if opts.SaveCheckpoint != nil {
+					if err = opts.SaveCheckpoint(opts.Checkpoint); err != nil {
+						return progress, err
+					}
+				}
+				if opts.OnProgress != nil {
+					opts.OnProgress(progress)
+				}
+			}
+		}
+
+		if !opts.Follow {
+			return progress, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return progress, nil
+		case <-time.After(opts.PollInterval):
+		}
+	}
+}
+
+// stopping with ctx is the expected way to end a mirror
+func ignoreCanceled(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// rateLimiter spreads the mirrored records evenly over time
+type rateLimiter struct {
+	perSecond int
+	start     time.Time
+	count     int64
+	now       func() time.Time
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+func newRateLimiter(perSecond int) *rateLimiter {
+	return &rateLimiter{
+		perSecond: perSecond,
+		now:       time.Now,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d):
+				return nil
+			}
+		},
+	}
+}
+
+// wait blocks until n more records can be mirrored without exceeding the rate limit
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	if l.perSecond <= 0 {
+		return nil
+	}
+	if l.start.IsZero() {
+		l.start = l.now()
+	}
+
+	// the records already allowed must be spread over this much time
+	due := l.start.Add(time.Duration(l.count) * time.Second / time.Duration(l.perSecond))
+	l.count += int64(n)
+
+	if delay := due.Sub(l.now()); delay > 0 {
+		return l.sleep(ctx, delay)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+)
+
+// fakeTopic is an in-memory topic which can be used as both a source and a sink
+type fakeTopic struct {
+	partitions [][]dataplane.Record
+	// first is the offset of the first record which has not been deleted, per partition
+	first []int64
+	// failAfter makes Write fail once this many records have been written, when set
+	failAfter int
+	written   int
+}
+
+func newFakeTopic(partitions int) *fakeTopic {
+	return &fakeTopic{
+		partitions: make([][]dataplane.Record, partitions),
+		first:      make([]int64, partitions),
+		failAfter:  -1,
+	}
+}
+
+func (t *fakeTopic) Offsets(ctx context.Context) ([]dataplane.PartitionOffsets, error) {
+	offsets := []dataplane.PartitionOffsets{}
+	for p, records := range t.partitions {
+		offsets = append(offsets, dataplane.PartitionOffsets{
+			Partition: p,
+			First:     t.first[p],
+			Last:      int64(len(records)),
+		})
+	}
+	return offsets, nil
+}
+
+func (t *fakeTopic) Read(ctx context.Context, partition int, offset int64, end int64) ([]dataplane.Record, error) {
+	return append([]dataplane.Record{}, t.partitions[partition][offset:end]...), nil
+}
+
+func (t *fakeTopic) Write(ctx context.Context, records []dataplane.Record) error {
+	for _, r := range records {
+		if t.failAfter >= 0 && t.written >= t.failAfter {
+			return errors.New("broker unavailable")
+		}
+		r.Offset = int64(len(t.partitions[r.Partition]))
+		t.partitions[r.Partition] = append(t.partitions[r.Partition], r)
+		t.written++
+	}
+	return nil
+}
+
+func (t *fakeTopic) produce(partition int, count int) {
+	for i := 0; i < count; i++ {
+		offset := int64(len(t.partitions[partition]))
+		t.partitions[partition] = append(t.partitions[partition], dataplane.Record{
+			Partition: partition,
+			Offset:    offset,
+			Key:       []byte(fmt.Sprintf("key-%v-%v", partition, offset)),
+			Value:     []byte(fmt.Sprintf("value-%v-%v", partition, offset)),
+			Headers:   []dataplane.Header{{Key: "source", Value: []byte("test")}},
+			Time:      time.Unix(1600000000+offset, 0),
+		})
+	}
+}
+
+func assertMirrored(t *testing.T, source *fakeTopic, target *fakeTopic) {
+	t.Helper()
+	for p := range source.partitions {
+		want := source.partitions[p][source.first[p]:]
+		got := target.partitions[p]
+		if len(got) != len(want) {
+			t.Fatalf("partition %v has %v records, want %v", p, len(got), len(want))
+		}
+		for i := range want {
+			if string(got[i].Key) != string(want[i].Key) ||
+				string(got[i].Value) != string(want[i].Value) ||
+				!reflect.DeepEqual(got[i].Headers, want[i].Headers) ||
+				!got[i].Time.Equal(want[i].Time) {
+				t.Errorf("partition %v record %v = %+v, want %+v", p, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	source := newFakeTopic(3)
+	source.produce(0, 7)
+	source.produce(1, 3)
+	target := newFakeTopic(3)
+
+	var lastProgress Progress
+	progress, err := Run(context.Background(), source, target, &Options{
+		BatchSize: 2,
+		OnProgress: func(p Progress) {
+			lastProgress = p
+		},
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	assertMirrored(t, source, target)
+
+	want := Progress{Mirrored: 10, Remaining: 0}
+	if progress != want || lastProgress != want {
+		t.Errorf("Run() progress = %+v, last reported %+v, want %+v", progress, lastProgress, want)
+	}
+}
+
+func TestRunResumesFromCheckpoint(t *testing.T) {
+	source := newFakeTopic(2)
+	source.produce(0, 6)
+	source.produce(1, 4)
+	target := newFakeTopic(2)
+	target.failAfter = 5
+
+	checkpointFile := filepath.Join(t.TempDir(), "checkpoint.json")
+	opts := &Options{
+		BatchSize:  2,
+		Checkpoint: NewCheckpoint("source", "target", "orders", "orders"),
+		SaveCheckpoint: func(c *Checkpoint) error {
+			return c.Save(checkpointFile)
+		},
+	}
+
+	if _, err := Run(context.Background(), source, target, opts); err == nil {
+		t.Fatal("Run() expected an error from the target")
+	}
+
+	checkpoint, err := LoadCheckpoint(checkpointFile)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint() error = %v", err)
+	}
+	if offset, _ := checkpoint.Offset(0); offset != 4 {
+		t.Errorf("checkpoint offset of partition 0 = %v, want 4", offset)
+	}
+
+	// the record of the failed batch which was written is mirrored again
+	target = newFakeTopic(2)
+	opts.Checkpoint = checkpoint
+	progress, err := Run(context.Background(), source, target, opts)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if progress.Mirrored != 6 {
+		t.Errorf("Run() mirrored %v records after resuming, want 6", progress.Mirrored)
+	}
+	if len(target.partitions[0]) != 2 || string(target.partitions[0][0].Key) != "key-0-4" {
+		t.Errorf("Run() did not resume partition 0 at offset 4: %+v", target.partitions[0])
+	}
+}
+
+func TestRunSkipsDeletedRecords(t *testing.T) {
+	source := newFakeTopic(1)
+	source.produce(0, 5)
+	source.first[0] = 3
+	target := newFakeTopic(1)
+
+	checkpoint := NewCheckpoint("", "", "", "")
+	checkpoint.SetOffset(0, 1)
+
+	progress, err := Run(context.Background(), source, target, &Options{Checkpoint: checkpoint})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if progress.Mirrored != 2 {
+		t.Errorf("Run() mirrored %v records, want 2", progress.Mirrored)
+	}
+	assertMirrored(t, source, target)
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	var slept []time.Duration
+
+	l := newRateLimiter(100)
+	l.now = func() time.Time { return now }
+	l.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		now = now.Add(d)
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := l.wait(context.Background(), 50); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+
+	want := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}
+	if !reflect.DeepEqual(slept, want) {
+		t.Errorf("wait() slept %v, want %v", slept, want)
+	}
+}
+
+func TestLoadCheckpointMissingFile(t *testing.T) {
+	checkpoint, err := LoadCheckpoint(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil || checkpoint != nil {
+		t.Errorf("LoadCheckpoint() = %v, %v, want nil, nil", checkpoint, err)
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"github.com/linkedin/goavro/v2"
+)
+
+type avroCodec struct {
+	codec *goavro.Codec
+}
+
+func newAvroCodec(definition string) (*avroCodec, error) {
+	codec, err := goavro.NewCodec(definition)
+	if err != nil {
+		return nil, err
+	}
+	return &avroCodec{codec: codec}, nil
+}
+
+func (c *avroCodec) Encode(text []byte) ([]byte, error) {
+	native, _, err := c.codec.NativeFromTextual(text)
+	if err != nil {
+		return nil, err
+	}
+	return c.codec.BinaryFromNative(nil, native)
+}
+
+func (c *avroCodec) Decode(payload []byte) ([]byte, error) {
+	native, _, err := c.codec.NativeFromBinary(payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.codec.TextualFromNative(nil, native)
+}
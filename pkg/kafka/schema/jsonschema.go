@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v3"
+)
+
+const jsonSchemaResource = "schema.json"
+
+// jsonSchemaCodec validates messages which are encoded as plain JSON
+type jsonSchemaCodec struct {
+	schema *jsonschema.Schema
+}
+
+func newJSONSchemaCodec(definition string) (*jsonSchemaCodec, error) {
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(jsonSchemaResource, strings.NewReader(definition)); err != nil {
+		return nil, err
+	}
+	schema, err := compiler.Compile(jsonSchemaResource)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonSchemaCodec{schema: schema}, nil
+}
+
+func (c *jsonSchemaCodec) Encode(text []byte) ([]byte, error) {
+	if err := c.schema.Validate(bytes.NewReader(text)); err != nil {
+		return nil, err
+	}
+	return text, nil
+}
+
+func (c *jsonSchemaCodec) Decode(payload []byte) ([]byte, error) {
+	if err := c.schema.Validate(bytes.NewReader(payload)); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+)
+
+const protobufFileName = "schema.proto"
+
+// protobufCodec encodes messages of a single message type.
+// The payload starts with the indexes of the message type in the schema, as the registries expect.
+type protobufCodec struct {
+	message *desc.MessageDescriptor
+	indexes []int
+}
+
+func newProtobufCodec(definition string, messageName string) (*protobufCodec, error) {
+	parser := protoparse.Parser{
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			if filename != protobufFileName {
+				return nil, fmt.Errorf("imports are not supported: %v", filename)
+			}
+			return ioutil.NopCloser(strings.NewReader(definition)), nil
+		},
+	}
+	files, err := parser.ParseFiles(protobufFileName)
+	if err != nil {
+		return nil, err
+	}
+	file := files[0]
+
+	message, err := findMessage(file, messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobufCodec{
+		message: message,
+		indexes: messageIndexes(message),
+	}, nil
+}
+
+func (c *protobufCodec) Encode(text []byte) ([]byte, error) {
+	msg := dynamic.NewMessage(c.message)
+	if err := msg.UnmarshalJSON(text); err != nil {
+		return nil, err
+	}
+	data, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(encodeIndexes(c.indexes), data...), nil
+}
+
+func (c *protobufCodec) Decode(payload []byte) ([]byte, error) {
+	indexes, data, err := decodeIndexes(payload)
+	if err != nil {
+		return nil, err
+	}
+	if fmt.Sprint(indexes) != fmt.Sprint(c.indexes) {
+		return nil, fmt.Errorf("message type %v does not match the message type of the schema %v", indexes, c.indexes)
+	}
+
+	msg := dynamic.NewMessage(c.message)
+	if err = msg.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return msg.MarshalJSON()
+}
+
+// find a message type by its name or full name, or the first message type when name is empty
+func findMessage(file *desc.FileDescriptor, name string) (*desc.MessageDescriptor, error) {
+	messages := file.GetMessageTypes()
+	if len(messages) == 0 {
+		return nil, errors.New("the Protobuf schema does not contain a message type")
+	}
+	if name == "" {
+		return messages[0], nil
+	}
+
+	for len(messages) > 0 {
+		md := messages[0]
+		messages = append(messages[1:], md.GetNestedMessageTypes()...)
+		if md.GetName() == name || md.GetFullyQualifiedName() == name {
+			return md, nil
+		}
+	}
+
+	return nil, fmt.Errorf("message type %q not found in the Protobuf schema", name)
+}
+
+// the position of the message type in the file, followed by its position in each parent message
+func messageIndexes(md *desc.MessageDescriptor) []int {
+	indexes := []int{}
+	for {
+		var siblings []*desc.MessageDescriptor
+		parent, ok := md.GetParent().(*desc.MessageDescriptor)
+		if ok {
+			siblings = parent.GetNestedMessageTypes()
+		} else {
+			siblings = md.GetFile().GetMessageTypes()
+		}
+		for i, s := range siblings {
+			if s == md {
+				indexes = append([]int{i}, indexes...)
+			}
+		}
+		if !ok {
+			return indexes
+		}
+		md = parent
+	}
+}
+
+// the first message type is encoded as a single 0, otherwise the number of indexes is followed by the indexes
+func encodeIndexes(indexes []int) []byte {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	data := []byte{}
+	n := binary.PutVarint(buf, int64(len(indexes)))
+	data = append(data, buf[:n]...)
+	for _, i := range indexes {
+		n = binary.PutVarint(buf, int64(i))
+		data = append(data, buf[:n]...)
+	}
+	return data
+}
+
+func decodeIndexes(payload []byte) ([]int, []byte, error) {
+	count, n := binary.Varint(payload)
+	if n <= 0 {
+		return nil, nil, errors.New("invalid Protobuf message indexes")
+	}
+	payload = payload[n:]
+	if count == 0 {
+		return []int{0}, payload, nil
+	}
+
+	indexes := []int{}
+	for i := int64(0); i < count; i++ {
+		index, n := binary.Varint(payload)
+		if n <= 0 {
+			return nil, nil, errors.New("invalid Protobuf message indexes")
+		}
+		indexes = append(indexes, int(index))
+		payload = payload[n:]
+	}
+	return indexes, payload, nil
+}
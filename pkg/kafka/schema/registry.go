@@ -0,0 +1,168 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const registryContentType = "application/vnd.schemaregistry.v1+json"
+
+// schema types used by the registry API
+var registrySchemaTypes = map[string]string{
+	FormatAvro:       "AVRO",
+	FormatProtobuf:   "PROTOBUF",
+	FormatJSONSchema: "JSON",
+}
+
+// Registry is a client for the Confluent compatible API of a schema registry.
+// For an Apicurio Registry, use the URL of its compatibility API, for example "https://registry.example.com/apis/ccompat/v6".
+type Registry struct {
+	URL        string
+	HTTPClient *http.Client
+
+	mu    sync.Mutex
+	cache map[int]*Schema
+}
+
+type registrySchema struct {
+	ID         int    `json:"id,omitempty"`
+	Schema     string `json:"schema,omitempty"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+type registryError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// NewRegistry creates a client for the schema registry at registryURL
+func NewRegistry(registryURL string) *Registry {
+	return &Registry{
+		URL:        strings.TrimSuffix(registryURL, "/"),
+		HTTPClient: http.DefaultClient,
+		cache:      map[int]*Schema{},
+	}
+}
+
+// SubjectForTopic returns the subject of the schema of the message values of a topic
+func SubjectForTopic(topic string) string {
+	return topic + "-value"
+}
+
+// GetSchema gets a schema by its ID
+func (r *Registry) GetSchema(id int) (*Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.cache[id]; ok {
+		return s, nil
+	}
+
+	var res registrySchema
+	if err := r.do(http.MethodGet, fmt.Sprintf("/schemas/ids/%v", id), nil, &res); err != nil {
+		return nil, err
+	}
+	res.ID = id
+
+	s, err := fromRegistrySchema(res)
+	if err != nil {
+		return nil, err
+	}
+	r.cache[id] = s
+
+	return s, nil
+}
+
+// GetLatestSchema gets the latest version of the schema of a subject
+func (r *Registry) GetLatestSchema(subject string) (*Schema, error) {
+	var res registrySchema
+	if err := r.do(http.MethodGet, fmt.Sprintf("/subjects/%v/versions/latest", url.PathEscape(subject)), nil, &res); err != nil {
+		return nil, err
+	}
+	return fromRegistrySchema(res)
+}
+
+// Register registers a schema under a subject and returns its ID.
+// The ID of the existing schema is returned when the same schema is already registered.
+func (r *Registry) Register(subject string, s *Schema) (int, error) {
+	req := registrySchema{
+		Schema:     s.Definition,
+		SchemaType: registrySchemaTypes[s.Format],
+	}
+
+	var res registrySchema
+	if err := r.do(http.MethodPost, fmt.Sprintf("/subjects/%v/versions", url.PathEscape(subject)), req, &res); err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+func (r *Registry) do(method string, path string, body interface{}, result interface{}) error {
+	var reqBody *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	} else {
+		reqBody = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, r.URL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", registryContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", registryContentType)
+	}
+
+	res, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+		var regErr registryError
+		if json.Unmarshal(data, &regErr) == nil && regErr.Message != "" {
+			return fmt.Errorf("schema registry error: %v", regErr.Message)
+		}
+		return fmt.Errorf("schema registry error: %v", res.Status)
+	}
+
+	return json.Unmarshal(data, result)
+}
+
+func fromRegistrySchema(res registrySchema) (*Schema, error) {
+	s := &Schema{
+		ID:         res.ID,
+		Definition: res.Schema,
+	}
+
+	// the schema type is omitted for Avro schemas
+	switch res.SchemaType {
+	case "", "AVRO":
+		s.Format = FormatAvro
+	case "PROTOBUF":
+		s.Format = FormatProtobuf
+	case "JSON":
+		s.Format = FormatJSONSchema
+	default:
+		return nil, fmt.Errorf("unsupported schema type: %v", res.SchemaType)
+	}
+
+	return s, nil
+}
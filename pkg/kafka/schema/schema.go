@@ -0,0 +1,87 @@
+// Package schema serializes messages with Avro, Protobuf and JSON Schema
+// using the wire format of the Confluent and Apicurio schema registries
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// FormatAvro is an Apache Avro schema
+	FormatAvro = "avro"
+	// FormatProtobuf is a Protocol Buffers schema
+	FormatProtobuf = "protobuf"
+	// FormatJSONSchema is a JSON Schema
+	FormatJSONSchema = "json-schema"
+)
+
+// ValidFormats are the supported schema formats
+var ValidFormats = []string{FormatAvro, FormatProtobuf, FormatJSONSchema}
+
+// Schema is a schema definition and the ID it is registered with
+type Schema struct {
+	// ID is the ID of the schema in the schema registry, 0 when it is not known
+	ID         int
+	Format     string
+	Definition string
+}
+
+// Codec converts messages between JSON text and the encoding of a schema
+type Codec interface {
+	// Encode converts a JSON document to the payload of a message
+	Encode(text []byte) ([]byte, error)
+	// Decode converts the payload of a message to a JSON document
+	Decode(payload []byte) ([]byte, error)
+}
+
+// FormatFromFile guesses the format of a schema file from its extension
+func FormatFromFile(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".avsc":
+		return FormatAvro, nil
+	case ".proto":
+		return FormatProtobuf, nil
+	case ".json":
+		return FormatJSONSchema, nil
+	default:
+		return "", fmt.Errorf(`unable to detect the format of schema file "%v"; use a ".avsc", ".proto" or ".json" file`, path)
+	}
+}
+
+// LoadFile reads a schema definition from a file
+func LoadFile(path string) (*Schema, error) {
+	format, err := FormatFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G304
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", "unable to read schema file", err)
+	}
+
+	return &Schema{
+		Format:     format,
+		Definition: string(data),
+	}, nil
+}
+
+// NewCodec creates a codec for a schema.
+// messageName selects the message type of a Protobuf schema, the first message type is used when it is empty.
+func NewCodec(s *Schema, messageName string) (Codec, error) {
+	switch s.Format {
+	case FormatAvro:
+		return newAvroCodec(s.Definition)
+	case FormatProtobuf:
+		return newProtobufCodec(s.Definition, messageName)
+	case FormatJSONSchema:
+		return newJSONSchemaCodec(s.Definition)
+	default:
+		return nil, errors.New("unsupported schema format: " + s.Format)
+	}
+}
@@ -0,0 +1,263 @@
+package schema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	avroSchema = `{
+  "type": "record",
+  "name": "Order",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "quantity", "type": "int"}
+  ]
+}`
+
+	protobufSchema = `syntax = "proto3";
+package shop;
+
+message Customer {
+  string name = 1;
+}
+
+message Order {
+  string id = 1;
+  int32 quantity = 2;
+
+  message Line {
+    string product = 1;
+  }
+}
+`
+
+	jsonSchema = `{
+  "type": "object",
+  "properties": {
+    "id": {"type": "string"},
+    "quantity": {"type": "integer"}
+  },
+  "required": ["id", "quantity"]
+}`
+
+	order = `{"id":"order-1","quantity":3}`
+)
+
+// fakeRegistry is an in-memory stand-in for the Confluent compatible schema registry API
+type fakeRegistry struct {
+	mu       sync.Mutex
+	schemas  []registrySchema
+	subjects map[string][]int
+}
+
+func newFakeRegistry() *httptest.Server {
+	r := &fakeRegistry{subjects: map[string][]int{}}
+	return httptest.NewServer(http.HandlerFunc(r.serve))
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 3 && parts[0] == "schemas" && parts[1] == "ids":
+		id, _ := strconv.Atoi(parts[2])
+		if id < 1 || id > len(r.schemas) {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(registryError{ErrorCode: 40403, Message: "Schema not found"})
+			return
+		}
+		s := r.schemas[id-1]
+		_ = json.NewEncoder(w).Encode(registrySchema{Schema: s.Schema, SchemaType: s.SchemaType})
+	case len(parts) == 3 && parts[0] == "subjects" && parts[2] == "versions" && req.Method == http.MethodPost:
+		var s registrySchema
+		_ = json.NewDecoder(req.Body).Decode(&s)
+		for _, id := range r.subjects[parts[1]] {
+			if r.schemas[id-1].Schema == s.Schema {
+				_ = json.NewEncoder(w).Encode(registrySchema{ID: id})
+				return
+			}
+		}
+		r.schemas = append(r.schemas, s)
+		id := len(r.schemas)
+		r.subjects[parts[1]] = append(r.subjects[parts[1]], id)
+		_ = json.NewEncoder(w).Encode(registrySchema{ID: id})
+	case len(parts) == 4 && parts[0] == "subjects" && parts[3] == "latest":
+		ids := r.subjects[parts[1]]
+		if len(ids) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(registryError{ErrorCode: 40401, Message: "Subject not found"})
+			return
+		}
+		s := r.schemas[ids[len(ids)-1]-1]
+		_ = json.NewEncoder(w).Encode(registrySchema{ID: ids[len(ids)-1], Schema: s.Schema, SchemaType: s.SchemaType})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func assertJSONEqual(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	_ = json.Unmarshal([]byte(want), &w)
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      *Schema
+		messageName string
+		text        string
+		wantErr     bool
+	}{
+		{name: "avro", schema: &Schema{ID: 1, Format: FormatAvro, Definition: avroSchema}, text: order},
+		{name: "protobuf", schema: &Schema{ID: 2, Format: FormatProtobuf, Definition: protobufSchema}, messageName: "Order", text: order},
+		{name: "nested protobuf message", schema: &Schema{ID: 3, Format: FormatProtobuf, Definition: protobufSchema}, messageName: "shop.Order.Line", text: `{"product":"book"}`},
+		{name: "json schema", schema: &Schema{ID: 4, Format: FormatJSONSchema, Definition: jsonSchema}, text: order},
+		{name: "invalid avro message", schema: &Schema{ID: 1, Format: FormatAvro, Definition: avroSchema}, text: `{"id":"order-1"}`, wantErr: true},
+		{name: "invalid json schema message", schema: &Schema{ID: 4, Format: FormatJSONSchema, Definition: jsonSchema}, text: `{"id":"order-1","quantity":"three"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			serializer, err := NewSerializer(tt.schema, tt.messageName)
+			if err != nil {
+				t.Fatalf("NewSerializer() error = %v", err)
+			}
+			data, err := serializer.Serialize([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Serialize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			id, _, err := SplitHeader(data)
+			if err != nil || id != tt.schema.ID {
+				t.Errorf("SplitHeader() = %v, %v, want schema ID %v", id, err, tt.schema.ID)
+			}
+
+			text, err := NewDeserializer(nil, tt.schema, tt.messageName).Deserialize(data)
+			if err != nil {
+				t.Fatalf("Deserialize() error = %v", err)
+			}
+			assertJSONEqual(t, text, tt.text)
+		})
+	}
+}
+
+func TestProtobufMessageIndexes(t *testing.T) {
+	codec, err := newProtobufCodec(protobufSchema, "Line")
+	if err != nil {
+		t.Fatalf("newProtobufCodec() error = %v", err)
+	}
+	if !reflect.DeepEqual(codec.indexes, []int{1, 0}) {
+		t.Errorf("indexes = %v, want [1 0]", codec.indexes)
+	}
+
+	indexes, rest, err := decodeIndexes(append(encodeIndexes([]int{1, 0}), 0xff))
+	if err != nil || !reflect.DeepEqual(indexes, []int{1, 0}) || len(rest) != 1 {
+		t.Errorf("decodeIndexes() = %v, %v, %v", indexes, rest, err)
+	}
+
+	if got := encodeIndexes([]int{0}); !reflect.DeepEqual(got, []byte{0}) {
+		t.Errorf("encodeIndexes([0]) = %v, want [0]", got)
+	}
+}
+
+func TestSplitHeader(t *testing.T) {
+	if _, _, err := SplitHeader([]byte(order)); err != ErrNotSchemaEncoded {
+		t.Errorf("SplitHeader() error = %v, want %v", err, ErrNotSchemaEncoded)
+	}
+
+	id, payload, err := SplitHeader(AddHeader(258, []byte("payload")))
+	if err != nil || id != 258 || string(payload) != "payload" {
+		t.Errorf("SplitHeader() = %v, %q, %v", id, payload, err)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	server := newFakeRegistry()
+	defer server.Close()
+
+	schemaFile := filepath.Join(t.TempDir(), "order.proto")
+	if err := ioutil.WriteFile(schemaFile, []byte(protobufSchema), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewRegistry(server.URL + "/")
+
+	// an unrelated schema is registered first so the IDs differ
+	if _, err := registry.Register("customers-value", &Schema{Format: FormatAvro, Definition: avroSchema}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	s, err := ResolveSchema(registry, schemaFile, "orders", 0)
+	if err != nil {
+		t.Fatalf("ResolveSchema() error = %v", err)
+	}
+	if s.ID != 2 || s.Format != FormatProtobuf {
+		t.Errorf("ResolveSchema() = %+v, want a Protobuf schema with ID 2", s)
+	}
+
+	// registering the same schema again returns the same ID
+	if again, _ := ResolveSchema(registry, schemaFile, "orders", 0); again.ID != s.ID {
+		t.Errorf("ResolveSchema() ID = %v, want %v", again.ID, s.ID)
+	}
+
+	latest, err := ResolveSchema(registry, "", "orders", 0)
+	if err != nil || latest.ID != s.ID || latest.Definition != protobufSchema {
+		t.Errorf("ResolveSchema() without a file = %+v, %v", latest, err)
+	}
+
+	serializer, err := NewSerializer(s, "Order")
+	if err != nil {
+		t.Fatalf("NewSerializer() error = %v", err)
+	}
+	data, err := serializer.Serialize([]byte(order))
+	if err != nil {
+		t.Fatalf("Serialize() error = %v", err)
+	}
+
+	text, err := NewDeserializer(NewRegistry(server.URL), nil, "Order").Deserialize(data)
+	if err != nil {
+		t.Fatalf("Deserialize() error = %v", err)
+	}
+	assertJSONEqual(t, text, order)
+
+	if _, err := registry.GetSchema(42); err == nil || !strings.Contains(err.Error(), "Schema not found") {
+		t.Errorf("GetSchema() error = %v, want the registry error", err)
+	}
+}
+
+func TestResolveSchemaRequiresID(t *testing.T) {
+	schemaFile := filepath.Join(t.TempDir(), "order.avsc")
+	if err := ioutil.WriteFile(schemaFile, []byte(avroSchema), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ResolveSchema(nil, schemaFile, "orders", 0); err == nil {
+		t.Error("ResolveSchema() expected an error without a registry or schema ID")
+	}
+
+	s, err := ResolveSchema(nil, schemaFile, "orders", 7)
+	if err != nil || s.ID != 7 || s.Format != FormatAvro {
+		t.Errorf("ResolveSchema() = %+v, %v", s, err)
+	}
+}
@@ -0,0 +1,134 @@
+package schema
+
+import (
+	"errors"
+	"sync"
+)
+
+// Serializer encodes message values with a registered schema
+type Serializer struct {
+	schemaID int
+	codec    Codec
+}
+
+// NewSerializer creates a serializer for a schema, the ID of the schema must be set
+func NewSerializer(s *Schema, messageName string) (*Serializer, error) {
+	if s.ID <= 0 {
+		return nil, errors.New("the schema ID is required to serialize messages")
+	}
+
+	codec, err := NewCodec(s, messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Serializer{schemaID: s.ID, codec: codec}, nil
+}
+
+// Serialize converts a JSON document to a message value in the wire format
+func (s *Serializer) Serialize(text []byte) ([]byte, error) {
+	payload, err := s.codec.Encode(text)
+	if err != nil {
+		return nil, err
+	}
+	return AddHeader(s.schemaID, payload), nil
+}
+
+// Deserializer decodes message values in the wire format.
+// The schema of each message is fetched from the schema registry, unless a local schema is used.
+type Deserializer struct {
+	registry    *Registry
+	local       *Schema
+	messageName string
+
+	mu     sync.Mutex
+	codecs map[int]Codec
+}
+
+// NewDeserializer creates a deserializer which uses either a schema registry or a local schema
+func NewDeserializer(registry *Registry, local *Schema, messageName string) *Deserializer {
+	return &Deserializer{
+		registry:    registry,
+		local:       local,
+		messageName: messageName,
+		codecs:      map[int]Codec{},
+	}
+}
+
+// Deserialize converts a message value in the wire format to a JSON document
+func (d *Deserializer) Deserialize(data []byte) ([]byte, error) {
+	schemaID, payload, err := SplitHeader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	codec, err := d.codec(schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.Decode(payload)
+}
+
+func (d *Deserializer) codec(schemaID int) (Codec, error) {
+	// the local schema is used for every message, whatever its schema ID
+	if d.local != nil {
+		schemaID = 0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if codec, ok := d.codecs[schemaID]; ok {
+		return codec, nil
+	}
+
+	s := d.local
+	if s == nil {
+		if d.registry == nil {
+			return nil, errors.New("a schema registry or a local schema is required to deserialize messages")
+		}
+		var err error
+		if s, err = d.registry.GetSchema(schemaID); err != nil {
+			return nil, err
+		}
+	}
+
+	codec, err := NewCodec(s, d.messageName)
+	if err != nil {
+		return nil, err
+	}
+	d.codecs[schemaID] = codec
+
+	return codec, nil
+}
+
+// ResolveSchema gets the schema used to produce messages to a topic.
+// A local schema file is registered when a registry is given, otherwise schemaID must be set.
+// Without a local schema file, the latest schema of the topic is fetched from the registry.
+func ResolveSchema(registry *Registry, schemaFile string, topic string, schemaID int) (*Schema, error) {
+	if schemaFile == "" {
+		if registry == nil {
+			return nil, errors.New("a schema registry or a local schema is required to serialize messages")
+		}
+		return registry.GetLatestSchema(SubjectForTopic(topic))
+	}
+
+	s, err := LoadFile(schemaFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case registry != nil:
+		if s.ID, err = registry.Register(SubjectForTopic(topic), s); err != nil {
+			return nil, err
+		}
+	case schemaID > 0:
+		s.ID = schemaID
+	default:
+		return nil, errors.New("the schema ID is required when a local schema is used without a schema registry")
+	}
+
+	return s, nil
+}
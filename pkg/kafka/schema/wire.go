@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	magicByte  = 0
+	headerSize = 5
+)
+
+// ErrNotSchemaEncoded is returned when a message does not start with the magic byte and schema ID
+var ErrNotSchemaEncoded = errors.New("message is not encoded with a schema")
+
+// AddHeader prefixes a payload with the magic byte and the schema ID
+func AddHeader(schemaID int, payload []byte) []byte {
+	data := make([]byte, headerSize, headerSize+len(payload))
+	data[0] = magicByte
+	binary.BigEndian.PutUint32(data[1:headerSize], uint32(schemaID))
+	return append(data, payload...)
+}
+
+// SplitHeader returns the schema ID and the payload of a message
+func SplitHeader(data []byte) (int, []byte, error) {
+	if len(data) < headerSize || data[0] != magicByte {
+		return 0, nil, ErrNotSchemaEncoded
+	}
+	return int(binary.BigEndian.Uint32(data[1:headerSize])), data[headerSize:], nil
+}
@@ -0,0 +1,87 @@
+package kafka
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+)
+
+// ServiceName is the name of the Kafka service used in commands
+const ServiceName = "kafka"
+
+// InstanceStatus is the status of a Kafka instance displayed by "rhoas status"
+type InstanceStatus struct {
+	ID                  string `json:"id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Status              string `json:"status,omitempty"`
+	BootstrapServerHost string `json:"bootstrap_server_host,omitempty" title:"Bootstrap URL"`
+	FailedReason        string `json:"failed_reason,omitempty" title:"Failed Reason"`
+}
+
+// ServiceProvider provides the Kafka service to the commands which work across services
+type ServiceProvider struct{}
+
+func (ServiceProvider) Name() string {
+	return ServiceName
+}
+
+func (ServiceProvider) Title() string {
+	return "Kafka"
+}
+
+func (ServiceProvider) CurrentID(cfg *config.Config) string {
+	if !cfg.HasKafka() {
+		return ""
+	}
+	return cfg.Services.Kafka.ClusterID
+}
+
+func (ServiceProvider) Use(cfg *config.Config, id string) {
+	cfg.Services.Kafka = &config.KafkaConfig{
+		ClusterID: id,
+	}
+}
+
+func (ServiceProvider) Unuse(cfg *config.Config) {
+	cfg.Services.Kafka = nil
+}
+
+func (ServiceProvider) Status(ctx context.Context, api *api.API, id string) (interface{}, error) {
+	kafkaInstance, _, err := GetKafkaByID(ctx, api.Kafka(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &InstanceStatus{
+		ID:                  kafkaInstance.GetId(),
+		Name:                kafkaInstance.GetName(),
+		Status:              kafkaInstance.GetStatus(),
+		BootstrapServerHost: kafkaInstance.GetBootstrapServerHost(),
+	}
+
+	if kafkaInstance.GetStatus() == "failed" {
+		status.FailedReason = kafkaInstance.GetFailedReason()
+	}
+
+	return status, nil
+}
+
+// Complete lists all the Kafka instances and returns the names which start with toComplete.
+// The names are matched here rather than in a search query, which would need toComplete to be escaped.
+func (ServiceProvider) Complete(ctx context.Context, api *api.API, toComplete string) ([]string, error) {
+	kafkas, err := ListAll(ctx, api.Kafka(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, k := range kafkas {
+		if strings.HasPrefix(k.GetName(), toComplete) {
+			names = append(names, k.GetName())
+		}
+	}
+
+	return names, nil
+}
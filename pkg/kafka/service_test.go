@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestServiceProviderComplete(t *testing.T) {
+	instances := []kafkamgmtclient.KafkaRequest{
+		newKafka("1", "orders"),
+		newKafka("2", "payments"),
+		newKafka("3", "orders-dev"),
+	}
+
+	// the instances are served two per page, and no search query is expected
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if search := r.URL.Query().Get("search"); search != "" {
+			t.Errorf("unexpected search query %q", search)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		items := instances[:2]
+		if r.URL.Query().Get("page") == "2" {
+			items = instances[2:]
+		}
+		_ = json.NewEncoder(w).Encode(kafkamgmtclient.KafkaRequestList{Items: items, Total: int32(len(instances))})
+	}))
+	t.Cleanup(server.Close)
+
+	testAPI := &api.API{
+		Kafka: func() kafkamgmtclient.DefaultApi {
+			return kafkamgmt.NewAPIClient(&kafkamgmt.Config{BaseURL: server.URL}).DefaultApi
+		},
+	}
+
+	tests := map[string][]string{
+		"ord":    {"orders", "orders-dev"},
+		"":       {"orders", "payments", "orders-dev"},
+		"o'%":    {},
+		"orders": {"orders", "orders-dev"},
+	}
+	for toComplete, want := range tests {
+		names, err := ServiceProvider{}.Complete(context.Background(), testAPI, toComplete)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(names, want) {
+			t.Errorf("Complete(%q) = %v, want %v", toComplete, names, want)
+		}
+	}
+}
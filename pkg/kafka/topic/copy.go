@@ -0,0 +1,115 @@
+package topic
+
+import (
+	"fmt"
+	"sort"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+const (
+	// CopyActionCreate means the topic does not exist in the target Kafka instance
+	CopyActionCreate = "create"
+	// CopyActionUpdate means the topic configuration differs in the target Kafka instance
+	CopyActionUpdate = "update"
+	// CopyActionConflict means the topic differs in a way that cannot be updated
+	CopyActionConflict = "conflict"
+	// CopyActionUnchanged means the topic is the same in both Kafka instances
+	CopyActionUnchanged = "unchanged"
+)
+
+// CopyDifferencePartitions is the key of the difference in the number of partitions
+const CopyDifferencePartitions = "partitions"
+
+// CopyableConfigKeys are the topic configuration keys which are copied between Kafka instances
+var CopyableConfigKeys = []string{RetentionMsKey, RetentionSizeKey}
+
+// CopyPlanItem describes what must be done to copy a single topic
+type CopyPlanItem struct {
+	Name       string
+	Action     string
+	Partitions int32
+	// Config contains the configuration entries which must be set in the target Kafka instance
+	Config map[string]string
+	// Differences describes how the topic differs between the Kafka instances
+	Differences []CopyDifference
+}
+
+// CopyDifference is a value which differs between the source and the target topic.
+// Key is either a configuration key or CopyDifferencePartitions.
+type CopyDifference struct {
+	Key    string
+	Source string
+	Target string
+}
+
+// PlanCopy compares the source topics with the topics in the target Kafka instance
+// and returns the actions needed to recreate the source topics in the target.
+// Partitions cannot be changed on an existing topic, so a different partition count is reported as a conflict.
+func PlanCopy(source []kafkainstanceclient.Topic, target []kafkainstanceclient.Topic) []CopyPlanItem {
+	targetByName := map[string]kafkainstanceclient.Topic{}
+	for _, t := range target {
+		targetByName[t.GetName()] = t
+	}
+
+	plan := []CopyPlanItem{}
+	for _, src := range source {
+		item := CopyPlanItem{
+			Name:       src.GetName(),
+			Partitions: int32(len(src.GetPartitions())),
+			Config:     map[string]string{},
+		}
+		srcConfig := copyableConfig(src)
+
+		dst, exists := targetByName[src.GetName()]
+		if !exists {
+			item.Action = CopyActionCreate
+			item.Config = srcConfig
+			plan = append(plan, item)
+			continue
+		}
+
+		item.Action = CopyActionUnchanged
+		dstConfig := copyableConfig(dst)
+		for _, key := range CopyableConfigKeys {
+			srcVal, ok := srcConfig[key]
+			if !ok || srcVal == dstConfig[key] {
+				continue
+			}
+			item.Config[key] = srcVal
+			item.Differences = append(item.Differences, CopyDifference{Key: key, Source: srcVal, Target: dstConfig[key]})
+			item.Action = CopyActionUpdate
+		}
+
+		dstPartitions := int32(len(dst.GetPartitions()))
+		if dstPartitions != item.Partitions {
+			item.Differences = append(item.Differences, CopyDifference{
+				Key:    CopyDifferencePartitions,
+				Source: fmt.Sprint(item.Partitions),
+				Target: fmt.Sprint(dstPartitions),
+			})
+			item.Action = CopyActionConflict
+		}
+
+		plan = append(plan, item)
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool {
+		return plan[i].Name < plan[j].Name
+	})
+
+	return plan
+}
+
+// get the configuration entries of a topic which can be copied
+func copyableConfig(t kafkainstanceclient.Topic) map[string]string {
+	config := map[string]string{}
+	for _, entry := range t.GetConfig() {
+		for _, key := range CopyableConfigKeys {
+			if entry.GetKey() == key && entry.Value != nil {
+				config[key] = entry.GetValue()
+			}
+		}
+	}
+	return config
+}
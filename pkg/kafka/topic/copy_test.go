@@ -0,0 +1,76 @@
+package topic
+
+import (
+	"reflect"
+	"testing"
+
+	kafkainstanceclient "github.com/redhat-developer/app-services-sdk-go/kafkainstance/apiv1internal/client"
+)
+
+func newTopic(name string, partitions int, retentionMs string) kafkainstanceclient.Topic {
+	t := kafkainstanceclient.NewTopic()
+	t.SetName(name)
+
+	p := []kafkainstanceclient.Partition{}
+	for i := 0; i < partitions; i++ {
+		p = append(p, *kafkainstanceclient.NewPartition(int32(i)))
+	}
+	t.SetPartitions(p)
+
+	entry := kafkainstanceclient.NewConfigEntry()
+	entry.SetKey(RetentionMsKey)
+	entry.SetValue(retentionMs)
+	t.SetConfig([]kafkainstanceclient.ConfigEntry{*entry})
+
+	return *t
+}
+
+// nolint:funlen
+func TestPlanCopy(t *testing.T) {
+	source := []kafkainstanceclient.Topic{
+		newTopic("orders", 3, "604800000"),
+		newTopic("payments", 1, "86400000"),
+		newTopic("invoices", 2, "604800000"),
+		newTopic("audit", 1, "604800000"),
+	}
+	target := []kafkainstanceclient.Topic{
+		newTopic("payments", 1, "604800000"),
+		newTopic("invoices", 1, "604800000"),
+		newTopic("audit", 1, "604800000"),
+	}
+
+	plan := PlanCopy(source, target)
+
+	want := []CopyPlanItem{
+		{
+			Name:       "audit",
+			Action:     CopyActionUnchanged,
+			Partitions: 1,
+			Config:     map[string]string{},
+		},
+		{
+			Name:        "invoices",
+			Action:      CopyActionConflict,
+			Partitions:  2,
+			Config:      map[string]string{},
+			Differences: []CopyDifference{{Key: CopyDifferencePartitions, Source: "2", Target: "1"}},
+		},
+		{
+			Name:       "orders",
+			Action:     CopyActionCreate,
+			Partitions: 3,
+			Config:     map[string]string{RetentionMsKey: "604800000"},
+		},
+		{
+			Name:        "payments",
+			Action:      CopyActionUpdate,
+			Partitions:  1,
+			Config:      map[string]string{RetentionMsKey: "86400000"},
+			Differences: []CopyDifference{{Key: RetentionMsKey, Source: "86400000", Target: "604800000"}},
+		},
+	}
+
+	if !reflect.DeepEqual(plan, want) {
+		t.Errorf("PlanCopy() = %+v, want %+v", plan, want)
+	}
+}
@@ -0,0 +1,184 @@
+package topic
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+)
+
+const (
+	// DecodeString shows message values as text
+	DecodeString = "string"
+	// DecodeJSON shows message values as indented JSON
+	DecodeJSON = "json"
+	// DecodeBase64 shows message values encoded with base64
+	DecodeBase64 = "base64"
+	// DecodeHex shows message values as hexadecimal bytes
+	DecodeHex = "hex"
+
+	// AllPartitions selects the messages of every partition
+	AllPartitions = -1
+	// NoOffset means that no start offset has been set
+	NoOffset = -1
+
+	browseBatchSize = 100
+)
+
+// ErrPartitionNotFound is returned when the selected partition does not exist in the topic
+var ErrPartitionNotFound = errors.New("partition not found")
+
+// ValidDecodings are the formats which message values can be shown in
+var ValidDecodings = []string{DecodeString, DecodeJSON, DecodeBase64, DecodeHex}
+
+// MessageSource reads the messages of a topic
+type MessageSource interface {
+	Offsets(ctx context.Context) ([]dataplane.PartitionOffsets, error)
+	OffsetAt(ctx context.Context, partition int, t time.Time) (int64, error)
+	Read(ctx context.Context, partition int, offset int64, end int64) ([]dataplane.Record, error)
+}
+
+// BrowseOptions selects the messages returned by BrowseMessages
+type BrowseOptions struct {
+	Partition int
+	// Offset is the offset to start reading from in each partition
+	Offset   int64
+	FromTime time.Time
+	ToTime   time.Time
+	// Limit is the maximum number of messages to return, 0 means no limit
+	Limit int
+	// Matches is used to search the messages when it is set
+	Matches func(dataplane.Record) bool
+}
+
+// BrowseMessages returns the messages of a topic which were available when it was called.
+// The partitions are read in order, until the limit of messages is reached.
+// nolint:funlen
+func BrowseMessages(ctx context.Context, source MessageSource, opts *BrowseOptions) ([]dataplane.Record, error) {
+	partitions, err := source.Offsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []dataplane.Record{}
+	found := false
+	for _, p := range partitions {
+		if opts.Partition != AllPartitions && opts.Partition != p.Partition {
+			continue
+		}
+		found = true
+
+		offset := p.First
+		if opts.Offset != NoOffset && opts.Offset > offset {
+			offset = opts.Offset
+		}
+		if !opts.FromTime.IsZero() {
+			timeOffset, err := source.OffsetAt(ctx, p.Partition, opts.FromTime)
+			if err != nil {
+				return nil, err
+			}
+			if timeOffset > offset {
+				offset = timeOffset
+			}
+		}
+
+	partitionLoop:
+		for offset < p.Last {
+			end := offset + browseBatchSize
+			if end > p.Last {
+				end = p.Last
+			}
+
+			records, err := source.Read(ctx, p.Partition, offset, end)
+			if err != nil {
+				return nil, err
+			}
+			if len(records) == 0 {
+				break
+			}
+
+			for _, r := range records {
+				if !opts.ToTime.IsZero() && r.Time.After(opts.ToTime) {
+					break partitionLoop
+				}
+				if opts.Matches != nil && !opts.Matches(r) {
+					continue
+				}
+				messages = append(messages, r)
+				if opts.Limit > 0 && len(messages) >= opts.Limit {
+					return messages, nil
+				}
+			}
+
+			offset = records[len(records)-1].Offset + 1
+		}
+	}
+
+	if !found {
+		return nil, ErrPartitionNotFound
+	}
+
+	return messages, nil
+}
+
+// NewMessageMatcher creates a function which checks if the key and value of a message
+// contain the given text, or match the given regular expressions when regex is set.
+// It returns nil when there is nothing to search for.
+func NewMessageMatcher(keyFilter string, valueFilter string, regex bool) (func(dataplane.Record) bool, error) {
+	if keyFilter == "" && valueFilter == "" {
+		return nil, nil
+	}
+
+	matchKey, err := newBytesMatcher(keyFilter, regex)
+	if err != nil {
+		return nil, err
+	}
+	matchValue, err := newBytesMatcher(valueFilter, regex)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(r dataplane.Record) bool {
+		return matchKey(r.Key) && matchValue(r.Value)
+	}, nil
+}
+
+func newBytesMatcher(filter string, regex bool) (func([]byte) bool, error) {
+	if filter == "" {
+		return func([]byte) bool { return true }, nil
+	}
+	if !regex {
+		return func(b []byte) bool { return bytes.Contains(b, []byte(filter)) }, nil
+	}
+
+	re, err := regexp.Compile(filter)
+	if err != nil {
+		return nil, err
+	}
+	return re.Match, nil
+}
+
+// DecodeValue formats a message value to be shown to the user.
+// Values which are not valid JSON are shown as text when decoding JSON.
+func DecodeValue(value []byte, decoding string) string {
+	switch decoding {
+	case DecodeJSON:
+		var out bytes.Buffer
+		if err := json.Indent(&out, value, "", "  "); err != nil {
+			return string(value)
+		}
+		return out.String()
+	case DecodeBase64:
+		return base64.StdEncoding.EncodeToString(value)
+	case DecodeHex:
+		return hex.EncodeToString(value)
+	default:
+		return string(value)
+	}
+}
@@ -0,0 +1,168 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/kafka/dataplane"
+)
+
+// memorySource is an in-memory topic with one record per second in each partition
+type memorySource struct {
+	partitions [][]dataplane.Record
+}
+
+func newMemorySource(partitions int, records int) *memorySource {
+	s := &memorySource{partitions: make([][]dataplane.Record, partitions)}
+	for p := 0; p < partitions; p++ {
+		for o := 0; o < records; o++ {
+			s.partitions[p] = append(s.partitions[p], dataplane.Record{
+				Partition: p,
+				Offset:    int64(o),
+				Key:       []byte(fmt.Sprintf("order-%v", o)),
+				Value:     []byte(fmt.Sprintf(`{"partition":%v,"offset":%v}`, p, o)),
+				Time:      time.Unix(int64(1000+o), 0),
+			})
+		}
+	}
+	return s
+}
+
+func (s *memorySource) Offsets(ctx context.Context) ([]dataplane.PartitionOffsets, error) {
+	offsets := []dataplane.PartitionOffsets{}
+	for p, records := range s.partitions {
+		offsets = append(offsets, dataplane.PartitionOffsets{Partition: p, First: 0, Last: int64(len(records))})
+	}
+	return offsets, nil
+}
+
+func (s *memorySource) OffsetAt(ctx context.Context, partition int, t time.Time) (int64, error) {
+	for _, r := range s.partitions[partition] {
+		if !r.Time.Before(t) {
+			return r.Offset, nil
+		}
+	}
+	return int64(len(s.partitions[partition])), nil
+}
+
+func (s *memorySource) Read(ctx context.Context, partition int, offset int64, end int64) ([]dataplane.Record, error) {
+	return s.partitions[partition][offset:end], nil
+}
+
+func offsetsOf(records []dataplane.Record) []string {
+	offsets := []string{}
+	for _, r := range records {
+		offsets = append(offsets, fmt.Sprintf("%v:%v", r.Partition, r.Offset))
+	}
+	return offsets
+}
+
+// nolint:funlen
+func TestBrowseMessages(t *testing.T) {
+	source := newMemorySource(2, 250)
+
+	matches, _ := NewMessageMatcher("order-1[0-9]$", "", true)
+
+	tests := []struct {
+		name    string
+		opts    BrowseOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "reads from the start of every partition up to the limit",
+			opts: BrowseOptions{Partition: AllPartitions, Offset: NoOffset, Limit: 2},
+			want: []string{"0:0", "0:1"},
+		},
+		{
+			name: "starts at an offset in a single partition",
+			opts: BrowseOptions{Partition: 1, Offset: 248, Limit: 5},
+			want: []string{"1:248", "1:249"},
+		},
+		{
+			name: "reads a time range",
+			opts: BrowseOptions{Partition: 1, Offset: NoOffset, FromTime: time.Unix(1120, 0), ToTime: time.Unix(1122, 0)},
+			want: []string{"1:120", "1:121", "1:122"},
+		},
+		{
+			name: "searches the keys of every partition",
+			opts: BrowseOptions{Partition: AllPartitions, Offset: NoOffset, Matches: matches, Limit: 12},
+			want: []string{"0:10", "0:11", "0:12", "0:13", "0:14", "0:15", "0:16", "0:17", "0:18", "0:19", "1:10", "1:11"},
+		},
+		{
+			name:    "fails when the partition does not exist",
+			opts:    BrowseOptions{Partition: 5, Offset: NoOffset},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BrowseMessages(context.Background(), source, &tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BrowseMessages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fmt.Sprint(offsetsOf(got)) != fmt.Sprint(tt.want) {
+				t.Errorf("BrowseMessages() = %v, want %v", offsetsOf(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMessageMatcher(t *testing.T) {
+	record := dataplane.Record{Key: []byte("customer-42"), Value: []byte(`{"status":"shipped"}`)}
+
+	tests := []struct {
+		name        string
+		keyFilter   string
+		valueFilter string
+		regex       bool
+		want        bool
+		wantErr     bool
+	}{
+		{name: "matches a substring of the value", valueFilter: "shipped", want: true},
+		{name: "matches both key and value", keyFilter: "customer", valueFilter: "shipped", want: true},
+		{name: "does not match a different key", keyFilter: "order", valueFilter: "shipped", want: false},
+		{name: "matches a regular expression", keyFilter: "^customer-[0-9]+$", regex: true, want: true},
+		{name: "fails on an invalid regular expression", valueFilter: "status(", regex: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := NewMessageMatcher(tt.keyFilter, tt.valueFilter, tt.regex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewMessageMatcher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := matches(record); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeValue(t *testing.T) {
+	tests := []struct {
+		decoding string
+		value    string
+		want     string
+	}{
+		{decoding: DecodeString, value: "hello", want: "hello"},
+		{decoding: DecodeJSON, value: `{"a":1}`, want: "{\n  \"a\": 1\n}"},
+		{decoding: DecodeJSON, value: "not json", want: "not json"},
+		{decoding: DecodeBase64, value: "hello", want: "aGVsbG8="},
+		{decoding: DecodeHex, value: "hello", want: "68656c6c6f"},
+	}
+	for _, tt := range tests {
+		if got := DecodeValue([]byte(tt.value), tt.decoding); got != tt.want {
+			t.Errorf("DecodeValue(%q, %v) = %q, want %q", tt.value, tt.decoding, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Package service defines the application services which are supported by the CLI.
+// Commands which work across all services, such as "rhoas status", use the service providers
+// instead of depending on any specific service.
+package service
+
+import (
+	"context"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+)
+
+// Provider is implemented by each application service
+type Provider interface {
+	// Name is the name of the service used in commands, for example "kafka"
+	Name() string
+	// Title is the name of the service displayed to users, for example "Kafka"
+	Title() string
+	// CurrentID returns the ID of the instance of the service currently used, or an empty string if none is used
+	CurrentID(cfg *config.Config) string
+	// Use sets the instance of the service currently used
+	Use(cfg *config.Config, id string)
+	// Unuse clears the instance of the service currently used
+	Unuse(cfg *config.Config)
+	// Status gets the status of an instance of the service.
+	// The status is a pointer to a struct, which fields are displayed by "rhoas status".
+	Status(ctx context.Context, api *api.API, id string) (interface{}, error)
+	// Complete returns the names of the instances of the service which start with toComplete
+	Complete(ctx context.Context, api *api.API, toComplete string) ([]string, error)
+}
+
+// Registry holds the providers of all supported services, in the order in which they are displayed
+type Registry struct {
+	providers []Provider
+}
+
+// NewRegistry creates a registry of service providers
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds a provider to the registry, replacing any provider with the same name
+func (r *Registry) Register(provider Provider) {
+	for i, p := range r.providers {
+		if p.Name() == provider.Name() {
+			r.providers[i] = provider
+			return
+		}
+	}
+	r.providers = append(r.providers, provider)
+}
+
+// Get gets the provider of a service by name
+func (r *Registry) Get(name string) (Provider, bool) {
+	for _, p := range r.providers {
+		if p.Name() == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Providers returns the providers of all services
+func (r *Registry) Providers() []Provider {
+	providers := make([]Provider, len(r.providers))
+	copy(providers, r.providers)
+	return providers
+}
+
+// Names returns the names of all services
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for _, p := range r.providers {
+		names = append(names, p.Name())
+	}
+	return names
+}
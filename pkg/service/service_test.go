@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+)
+
+type fakeProvider struct {
+	name  string
+	title string
+}
+
+func (p fakeProvider) Name() string                    { return p.name }
+func (p fakeProvider) Title() string                   { return p.title }
+func (p fakeProvider) CurrentID(*config.Config) string { return "" }
+func (p fakeProvider) Use(*config.Config, string)      {}
+func (p fakeProvider) Unuse(*config.Config)            {}
+func (p fakeProvider) Status(context.Context, *api.API, string) (interface{}, error) {
+	return nil, nil
+}
+func (p fakeProvider) Complete(context.Context, *api.API, string) ([]string, error) {
+	return nil, nil
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(fakeProvider{name: "kafka", title: "Kafka"}, fakeProvider{name: "service-registry"})
+
+	if got := r.Names(); !reflect.DeepEqual(got, []string{"kafka", "service-registry"}) {
+		t.Errorf("Names() = %v", got)
+	}
+
+	// registering a provider with an existing name replaces it in place
+	r.Register(fakeProvider{name: "kafka", title: "Apache Kafka"})
+	r.Register(fakeProvider{name: "connectors"})
+
+	if got := r.Names(); !reflect.DeepEqual(got, []string{"kafka", "service-registry", "connectors"}) {
+		t.Errorf("Names() = %v", got)
+	}
+
+	p, ok := r.Get("kafka")
+	if !ok || p.Title() != "Apache Kafka" {
+		t.Errorf("Get() = %v, %v", p, ok)
+	}
+
+	if _, ok = r.Get("unknown"); ok {
+		t.Error("Get() found an unknown service")
+	}
+
+	// the returned slice is a copy
+	providers := r.Providers()
+	providers[0] = nil
+	if p, _ := r.Get("kafka"); p == nil {
+		t.Error("Providers() returned the internal slice")
+	}
+}
@@ -1,11 +1,13 @@
 package credentials
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/redhat-developer/app-services-cli/pkg/color"
 
@@ -77,6 +79,48 @@ func Write(output string, filepath string, credentials *Credentials) error {
 	return ioutil.WriteFile(trueFilePath, fileData, 0600)
 }
 
+// Read reads the credentials from a file written by Write, in any of its output formats
+func Read(filepath string) (*Credentials, error) {
+	fileData, err := ioutil.ReadFile(os.ExpandEnv(filepath))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]string
+	if body := strings.TrimSpace(string(fileData)); strings.HasPrefix(body, "{") {
+		if err = json.Unmarshal([]byte(body), &fields); err != nil {
+			return nil, fmt.Errorf("unable to read the credentials file %v: %w", filepath, err)
+		}
+	} else {
+		fields = map[string]string{}
+		for _, line := range strings.Split(body, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if parts := strings.SplitN(line, "=", 2); len(parts) == 2 {
+				fields[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+			}
+		}
+	}
+
+	credentials := &Credentials{}
+	for key, value := range fields {
+		switch key {
+		case "clientID", "CLIENT_ID":
+			credentials.ClientID = value
+		case "clientSecret", "CLIENT_SECRET":
+			credentials.ClientSecret = value
+		}
+	}
+
+	if credentials.ClientID == "" || credentials.ClientSecret == "" {
+		return nil, fmt.Errorf("the credentials file %v does not contain a client ID and a client secret", filepath)
+	}
+
+	return credentials, nil
+}
+
 func getFileFormat(output string) (format string) {
 	switch output {
 	case "env":
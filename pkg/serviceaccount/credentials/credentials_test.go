@@ -0,0 +1,41 @@
+package credentials
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rhoas-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	want := Credentials{ClientID: "srvc-acct-1", ClientSecret: "secret=value"}
+	for _, format := range []string{"env", "json", "properties"} {
+		path := filepath.Join(dir, "credentials."+format)
+		if err = Write(format, path, &want); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := Read(path)
+		if err != nil {
+			t.Errorf("Read() of the %v format error = %v", format, err)
+			continue
+		}
+		if *got != want {
+			t.Errorf("Read() of the %v format = %+v, want %+v", format, *got, want)
+		}
+	}
+
+	path := filepath.Join(dir, "empty.env")
+	if err = ioutil.WriteFile(path, []byte("## Generated by rhoas cli\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Read(path); err == nil {
+		t.Error("Read() of a file without credentials did not fail")
+	}
+}
@@ -0,0 +1,112 @@
+// Package filter contains functions to filter and sort lists of service accounts
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+const (
+	SortByName      = "name"
+	SortByOwner     = "owner"
+	SortByCreatedAt = "created-at"
+)
+
+// ErrInvalidNamePattern is returned by Filter when the name pattern is not a valid glob pattern
+var ErrInvalidNamePattern = errors.New("invalid name pattern")
+
+// ValidSortFields is the list of fields service accounts can be sorted by
+var ValidSortFields = []string{SortByName, SortByOwner, SortByCreatedAt}
+
+// Options defines the criteria a service account must match
+// Empty values are ignored
+type Options struct {
+	// Owner is the exact username of the owner
+	Owner string
+	// NamePattern is a glob pattern, such as "rhoascli-*", the name must match
+	NamePattern string
+	// CreatedBefore only matches service accounts created before this time
+	CreatedBefore time.Time
+	// CreatedAfter only matches service accounts created after this time
+	CreatedAfter time.Time
+}
+
+// Filter returns the service accounts which match all criteria in opts
+func Filter(items []kafkamgmtclient.ServiceAccountListItem, opts *Options) ([]kafkamgmtclient.ServiceAccountListItem, error) {
+	if opts.NamePattern != "" {
+		if _, err := path.Match(opts.NamePattern, ""); err != nil {
+			return nil, fmt.Errorf(`%w "%v": %v`, ErrInvalidNamePattern, opts.NamePattern, err)
+		}
+	}
+
+	filtered := []kafkamgmtclient.ServiceAccountListItem{}
+	for _, sa := range items {
+		if opts.Owner != "" && sa.GetOwner() != opts.Owner {
+			continue
+		}
+		if opts.NamePattern != "" {
+			// the pattern has been validated above
+			if matched, _ := path.Match(opts.NamePattern, sa.GetName()); !matched {
+				continue
+			}
+		}
+		createdAt := sa.GetCreatedAt()
+		// the age of a service account without a creation time is unknown
+		if (!opts.CreatedBefore.IsZero() || !opts.CreatedAfter.IsZero()) && createdAt.IsZero() {
+			continue
+		}
+		if !opts.CreatedBefore.IsZero() && !createdAt.Before(opts.CreatedBefore) {
+			continue
+		}
+		if !opts.CreatedAfter.IsZero() && !createdAt.After(opts.CreatedAfter) {
+			continue
+		}
+
+		filtered = append(filtered, sa)
+	}
+
+	return filtered, nil
+}
+
+// Sort sorts the service accounts in place by the given field
+func Sort(items []kafkamgmtclient.ServiceAccountListItem, field string) error {
+	var less func(i, j int) bool
+	switch field {
+	case SortByName:
+		less = func(i, j int) bool {
+			return items[i].GetName() < items[j].GetName()
+		}
+	case SortByOwner:
+		less = func(i, j int) bool {
+			return items[i].GetOwner() < items[j].GetOwner()
+		}
+	case SortByCreatedAt:
+		less = func(i, j int) bool {
+			return items[i].GetCreatedAt().Before(items[j].GetCreatedAt())
+		}
+	default:
+		return fmt.Errorf(`invalid sort field "%v", valid options are: "%v"`, field, strings.Join(ValidSortFields, `", "`))
+	}
+
+	sort.SliceStable(items, less)
+
+	return nil
+}
+
+// ParseDate parses a date in the format "2006-01-02" or an RFC3339 timestamp
+func ParseDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(`invalid date "%v"; use the format "YYYY-MM-DD" or an RFC3339 timestamp`, s)
+	}
+	return t, nil
+}
@@ -0,0 +1,154 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func newServiceAccount(name string, owner string, createdAt time.Time) kafkamgmtclient.ServiceAccountListItem {
+	sa := kafkamgmtclient.NewServiceAccountListItem()
+	sa.SetName(name)
+	sa.SetOwner(owner)
+	sa.SetCreatedAt(createdAt)
+	return *sa
+}
+
+func names(items []kafkamgmtclient.ServiceAccountListItem) []string {
+	n := []string{}
+	for _, sa := range items {
+		n = append(n, sa.GetName())
+	}
+	return n
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// nolint:funlen
+func TestFilter(t *testing.T) {
+	now := time.Now()
+	items := []kafkamgmtclient.ServiceAccountListItem{
+		newServiceAccount("rhoascli-1600000000", "alice", now.Add(-100*24*time.Hour)),
+		newServiceAccount("rhoascli-1620000000", "bob", now.Add(-10*24*time.Hour)),
+		newServiceAccount("orders-service", "alice", now.Add(-200*24*time.Hour)),
+		newServiceAccount("rhoascli-unknown", "bob", time.Time{}),
+	}
+
+	tests := []struct {
+		name    string
+		opts    *Options
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "empty options match everything",
+			opts: &Options{},
+			want: []string{"rhoascli-1600000000", "rhoascli-1620000000", "orders-service", "rhoascli-unknown"},
+		},
+		{
+			name: "filters by owner",
+			opts: &Options{Owner: "alice"},
+			want: []string{"rhoascli-1600000000", "orders-service"},
+		},
+		{
+			name: "filters by name pattern",
+			opts: &Options{NamePattern: "rhoascli-*"},
+			want: []string{"rhoascli-1600000000", "rhoascli-1620000000", "rhoascli-unknown"},
+		},
+		{
+			name: "filters by creation date, skipping service accounts without a creation time",
+			opts: &Options{CreatedBefore: now.Add(-90 * 24 * time.Hour)},
+			want: []string{"rhoascli-1600000000", "orders-service"},
+		},
+		{
+			name: "combines all criteria",
+			opts: &Options{
+				NamePattern:   "rhoascli-*",
+				CreatedBefore: now.Add(-90 * 24 * time.Hour),
+				CreatedAfter:  now.Add(-150 * 24 * time.Hour),
+			},
+			want: []string{"rhoascli-1600000000"},
+		},
+		{
+			name:    "fails on an invalid pattern",
+			opts:    &Options{NamePattern: "rhoascli-["},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Filter(items, tt.opts)
+			if (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrInvalidNamePattern)) {
+				t.Errorf("Filter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !equal(names(got), tt.want) {
+				t.Errorf("Filter() = %v, want %v", names(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	now := time.Now()
+	items := []kafkamgmtclient.ServiceAccountListItem{
+		newServiceAccount("b", "carol", now.Add(-time.Hour)),
+		newServiceAccount("c", "alice", now.Add(-3*time.Hour)),
+		newServiceAccount("a", "bob", now.Add(-2*time.Hour)),
+	}
+
+	tests := []struct {
+		name    string
+		field   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "sorts by name",
+			field: SortByName,
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "sorts by owner",
+			field: SortByOwner,
+			want:  []string{"c", "a", "b"},
+		},
+		{
+			name:  "sorts by creation date",
+			field: SortByCreatedAt,
+			want:  []string{"c", "a", "b"},
+		},
+		{
+			name:    "fails on an unknown field",
+			field:   "client-id",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := append([]kafkamgmtclient.ServiceAccountListItem{}, items...)
+			err := Sort(sorted, tt.field)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sort() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !equal(names(sorted), tt.want) {
+				t.Errorf("Sort() = %v, want %v", names(sorted), tt.want)
+			}
+		})
+	}
+}
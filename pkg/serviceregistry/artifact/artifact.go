@@ -0,0 +1,223 @@
+// Package artifact is a client for the artifacts API (v2) of a Service Registry instance
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultGroup is the group used for artifacts when no group is given
+	DefaultGroup = "default"
+
+	apiPath = "/apis/registry/v2"
+)
+
+// ValidTypes are the artifact types supported by the registry
+var ValidTypes = []string{"AVRO", "PROTOBUF", "JSON", "OPENAPI", "ASYNCAPI", "GRAPHQL", "KCONNECT", "WSDL", "XSD", "XML"}
+
+// Metadata describes an artifact in the registry
+type Metadata struct {
+	GroupID     string   `json:"groupId,omitempty"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	State       string   `json:"state,omitempty"`
+	Version     string   `json:"version,omitempty"`
+	GlobalID    int64    `json:"globalId,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	CreatedBy   string   `json:"createdBy,omitempty"`
+	CreatedOn   string   `json:"createdOn,omitempty"`
+	ModifiedBy  string   `json:"modifiedBy,omitempty"`
+	ModifiedOn  string   `json:"modifiedOn,omitempty"`
+}
+
+// List is a page of artifacts
+type List struct {
+	Artifacts []Metadata `json:"artifacts"`
+	Count     int        `json:"count"`
+}
+
+// Error is an error returned by the registry API
+type Error struct {
+	StatusCode int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("service registry error: %v", e.Message)
+}
+
+// IsNotFound returns true when err is a "not found" error from the registry API
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*Error)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Client manages the artifacts of a Service Registry instance
+type Client struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a client for the registry instance at registryURL
+func NewClient(registryURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		URL:        strings.TrimSuffix(registryURL, "/") + apiPath,
+		HTTPClient: httpClient,
+	}
+}
+
+// Create creates an artifact in a group.
+// The registry generates the ID when id is empty, and detects the type from the content when artifactType is empty.
+func (c *Client) Create(ctx context.Context, group string, id string, artifactType string, content []byte) (*Metadata, error) {
+	header := http.Header{}
+	if id != "" {
+		header.Set("X-Registry-ArtifactId", id)
+	}
+	if artifactType != "" {
+		header.Set("X-Registry-ArtifactType", artifactType)
+	}
+
+	var metadata Metadata
+	err := c.do(ctx, http.MethodPost, artifactsPath(group), header, content, &metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// Get gets the content of the latest version of an artifact
+func (c *Client) Get(ctx context.Context, group string, id string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.do(ctx, http.MethodGet, artifactPath(group, id), nil, nil, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GetMetadata gets the metadata of the latest version of an artifact
+func (c *Client) GetMetadata(ctx context.Context, group string, id string) (*Metadata, error) {
+	var metadata Metadata
+	if err := c.do(ctx, http.MethodGet, artifactPath(group, id)+"/meta", nil, nil, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// List lists the artifacts of a group, or of all groups when group is empty
+func (c *Client) List(ctx context.Context, group string, offset int, limit int) (*List, error) {
+	query := url.Values{}
+	if group != "" {
+		query.Set("group", group)
+	}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+
+	var list List
+	if err := c.do(ctx, http.MethodGet, "/search/artifacts?"+query.Encode(), nil, nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// Update creates a new version of an artifact with the given content
+func (c *Client) Update(ctx context.Context, group string, id string, content []byte) (*Metadata, error) {
+	var metadata Metadata
+	if err := c.do(ctx, http.MethodPut, artifactPath(group, id), nil, content, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// Delete deletes an artifact and all of its versions
+func (c *Client) Delete(ctx context.Context, group string, id string) error {
+	return c.do(ctx, http.MethodDelete, artifactPath(group, id), nil, nil, nil)
+}
+
+// ReadContent reads the content of an artifact from a file, or from in when file is empty or "-"
+func ReadContent(file string, in io.Reader) ([]byte, error) {
+	if file == "" || file == "-" {
+		return ioutil.ReadAll(in)
+	}
+	return ioutil.ReadFile(file)
+}
+
+func artifactsPath(group string) string {
+	if group == "" {
+		group = DefaultGroup
+	}
+	return fmt.Sprintf("/groups/%v/artifacts", url.PathEscape(group))
+}
+
+func artifactPath(group string, id string) string {
+	return fmt.Sprintf("%v/%v", artifactsPath(group), url.PathEscape(id))
+}
+
+// do sends a request to the registry.
+// The response is decoded as JSON into result, unless result is an io.Writer in which case the raw body is copied to it.
+func (c *Client) do(ctx context.Context, method string, path string, header http.Header, body []byte, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.URL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType(body))
+	}
+	if _, raw := result.(io.Writer); !raw {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= 300 {
+		apiErr := &Error{}
+		if json.Unmarshal(data, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = res.Status
+		}
+		apiErr.StatusCode = res.StatusCode
+		return apiErr
+	}
+
+	switch r := result.(type) {
+	case nil:
+		return nil
+	case io.Writer:
+		_, err = r.Write(data)
+		return err
+	default:
+		return json.Unmarshal(data, result)
+	}
+}
+
+// content which is not JSON, such as Protobuf or XML schemas, is sent as plain text
+func contentType(content []byte) string {
+	if json.Valid(content) {
+		return "application/json"
+	}
+	return "text/plain"
+}
@@ -0,0 +1,197 @@
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeRegistry is an in-memory stand-in for the artifacts API of a registry instance
+type fakeRegistry struct {
+	mu        sync.Mutex
+	artifacts map[string]*fakeArtifact
+	order     []string
+}
+
+type fakeArtifact struct {
+	metadata Metadata
+	content  []byte
+}
+
+func newFakeRegistry() *httptest.Server {
+	r := &fakeRegistry{artifacts: map[string]*fakeArtifact{}}
+	return httptest.NewServer(http.HandlerFunc(r.serve))
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.Header.Get("Authorization") != "Bearer token" {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, apiPath)
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	body, _ := ioutil.ReadAll(req.Body)
+
+	switch {
+	case path == "/search/artifacts" && req.Method == http.MethodGet:
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		list := List{Artifacts: []Metadata{}}
+		for _, key := range r.order {
+			a := r.artifacts[key]
+			if group := req.URL.Query().Get("group"); group != "" && a.metadata.GroupID != group {
+				continue
+			}
+			list.Count++
+			if list.Count > offset && len(list.Artifacts) < limit {
+				list.Artifacts = append(list.Artifacts, a.metadata)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case len(parts) == 3 && parts[0] == "groups" && parts[2] == "artifacts" && req.Method == http.MethodPost:
+		id := req.Header.Get("X-Registry-ArtifactId")
+		if id == "" {
+			id = "generated-" + strconv.Itoa(len(r.order)+1)
+		}
+		key := parts[1] + "/" + id
+		if _, ok := r.artifacts[key]; ok {
+			writeError(w, http.StatusConflict, "An artifact with ID '"+id+"' already exists")
+			return
+		}
+		a := &fakeArtifact{
+			metadata: Metadata{GroupID: parts[1], ID: id, Type: req.Header.Get("X-Registry-ArtifactType"), Version: "1", State: "ENABLED"},
+			content:  body,
+		}
+		r.artifacts[key] = a
+		r.order = append(r.order, key)
+		_ = json.NewEncoder(w).Encode(a.metadata)
+	case len(parts) >= 4 && parts[0] == "groups" && parts[2] == "artifacts":
+		a, ok := r.artifacts[parts[1]+"/"+parts[3]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "No artifact with ID '"+parts[3]+"' in group '"+parts[1]+"' was found.")
+			return
+		}
+		switch {
+		case len(parts) == 5 && parts[4] == "meta":
+			_ = json.NewEncoder(w).Encode(a.metadata)
+		case req.Method == http.MethodGet:
+			_, _ = w.Write(a.content)
+		case req.Method == http.MethodPut:
+			version, _ := strconv.Atoi(a.metadata.Version)
+			a.metadata.Version = strconv.Itoa(version + 1)
+			a.content = body
+			_ = json.NewEncoder(w).Encode(a.metadata)
+		case req.Method == http.MethodDelete:
+			delete(r.artifacts, parts[1]+"/"+parts[3])
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Error{StatusCode: status, Message: message})
+}
+
+type tokenTransport struct{}
+
+func (tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer token")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeRegistry()
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", &http.Client{Transport: tokenTransport{}})
+	ctx := context.Background()
+	schema := []byte(`{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`)
+
+	created, err := client.Create(ctx, "", "orders-value", "AVRO", schema)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.GroupID != DefaultGroup || created.ID != "orders-value" || created.Type != "AVRO" {
+		t.Errorf("Create() = %+v", created)
+	}
+
+	if _, err = client.Create(ctx, DefaultGroup, "orders-value", "", schema); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("Create() error = %v, want a conflict", err)
+	}
+
+	generated, err := client.Create(ctx, "shop", "", "", []byte("syntax = \"proto3\";"))
+	if err != nil || generated.ID == "" {
+		t.Fatalf("Create() without an ID = %+v, %v", generated, err)
+	}
+
+	content, err := client.Get(ctx, DefaultGroup, "orders-value")
+	if err != nil || string(content) != string(schema) {
+		t.Errorf("Get() = %s, %v", content, err)
+	}
+
+	updated, err := client.Update(ctx, DefaultGroup, "orders-value", []byte(`{"type":"string"}`))
+	if err != nil || updated.Version != "2" {
+		t.Errorf("Update() = %+v, %v", updated, err)
+	}
+
+	all, err := client.List(ctx, "", 0, 10)
+	if err != nil || all.Count != 2 || len(all.Artifacts) != 2 {
+		t.Errorf("List() = %+v, %v", all, err)
+	}
+
+	page, err := client.List(ctx, "", 1, 10)
+	if err != nil || page.Count != 2 || len(page.Artifacts) != 1 || page.Artifacts[0].GroupID != "shop" {
+		t.Errorf("List() with an offset = %+v, %v", page, err)
+	}
+
+	group, err := client.List(ctx, "shop", 0, 10)
+	if err != nil || group.Count != 1 {
+		t.Errorf("List() of a group = %+v, %v", group, err)
+	}
+
+	if err = client.Delete(ctx, DefaultGroup, "orders-value"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	_, err = client.GetMetadata(ctx, DefaultGroup, "orders-value")
+	if !IsNotFound(err) {
+		t.Errorf("GetMetadata() error = %v, want not found", err)
+	}
+}
+
+func TestClientError(t *testing.T) {
+	server := newFakeRegistry()
+	defer server.Close()
+
+	// requests without a token are rejected
+	_, err := NewClient(server.URL, nil).List(context.Background(), "", 0, 10)
+	apiErr, ok := err.(*Error)
+	if !ok || apiErr.StatusCode != http.StatusUnauthorized || apiErr.Message != "Unauthorized" {
+		t.Errorf("List() error = %#v", err)
+	}
+	if IsNotFound(err) {
+		t.Error("IsNotFound() = true for an unauthorized error")
+	}
+}
+
+func TestContentType(t *testing.T) {
+	if got := contentType([]byte(`{"type":"string"}`)); got != "application/json" {
+		t.Errorf("contentType() = %v, want application/json", got)
+	}
+	if got := contentType([]byte(`syntax = "proto3";`)); got != "text/plain" {
+		t.Errorf("contentType() = %v, want text/plain", got)
+	}
+}
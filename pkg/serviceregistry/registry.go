@@ -0,0 +1,126 @@
+// Package serviceregistry contains helpers for Service Registry instances
+package serviceregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/redhat-developer/app-services-cli/pkg/common/commonerr"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/serviceregistry/registryerr"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+)
+
+const (
+	queryLimit = "1000"
+)
+
+var validNameRegexp = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+// ValidateName validates the proposed name of a Service Registry instance
+func ValidateName(val interface{}) error {
+	name, ok := val.(string)
+	if !ok {
+		return commonerr.NewCastError(val, "string")
+	}
+
+	if len(name) < 1 || len(name) > 32 {
+		return errors.New("Service Registry instance name must be between 1 and 32 characters")
+	}
+
+	if !validNameRegexp.MatchString(name) {
+		return registryerr.InvalidNameError(name)
+	}
+
+	return nil
+}
+
+// ParseID converts the ID of a Service Registry instance to the type used by the API
+func ParseID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return 0, registryerr.InvalidIDError(id)
+	}
+	return int32(n), nil
+}
+
+// FormatID converts the ID of a Service Registry instance to the format used in the config
+func FormatID(id int32) string {
+	return strconv.Itoa(int(id))
+}
+
+func GetRegistryByID(ctx context.Context, api srsmgmtclient.RegistriesApi, id string) (*srsmgmtclient.Registry, *http.Response, error) {
+	registryID, err := ParseID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registry, httpResponse, err := api.GetRegistry(ctx, registryID).Execute()
+	if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+		return nil, httpResponse, registryerr.NotFoundByIDError(id)
+	}
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	return &registry, httpResponse, nil
+}
+
+func GetRegistryByName(ctx context.Context, api srsmgmtclient.RegistriesApi, name string) (*srsmgmtclient.Registry, *http.Response, error) {
+	r := api.GetRegistries(ctx)
+	r = r.Search(fmt.Sprintf("name = %v", name))
+	registryList, httpResponse, err := r.Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	// names do not have to be unique, the first instance with the name is used
+	for _, registry := range registryList.GetItems() {
+		if registry.GetName() == name {
+			registry := registry
+			return &registry, httpResponse, nil
+		}
+	}
+
+	return nil, httpResponse, registryerr.NotFoundByNameError(name)
+}
+
+func InteractiveSelect(connection connection.Connection, logger logging.Logger) (*srsmgmtclient.Registry, error) {
+	api := connection.API()
+
+	response, _, err := api.ServiceRegistryMgmt().GetRegistries(context.Background()).Size(queryLimit).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list Service Registry instances: %w", err)
+	}
+
+	items := response.GetItems()
+	if len(items) == 0 {
+		logger.Info("No Service Registry instances were found.")
+		return nil, nil
+	}
+
+	registries := []string{}
+	for _, registry := range items {
+		registries = append(registries, fmt.Sprintf("%v (%v)", registry.GetName(), registry.GetId()))
+	}
+
+	prompt := &survey.Select{
+		Message:  "Select Service Registry instance to use:",
+		Options:  registries,
+		PageSize: 10,
+	}
+
+	var selectedIndex int
+	err = survey.AskOne(prompt, &selectedIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &items[selectedIndex], nil
+}
@@ -0,0 +1,98 @@
+package serviceregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	srsmgmt "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1"
+	srsmgmtclient "github.com/redhat-developer/app-services-sdk-go/srsmgmt/apiv1/client"
+)
+
+func newRegistriesAPI(t *testing.T, registries []srsmgmtclient.Registry) srsmgmtclient.RegistriesApi {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path == "/api/serviceregistry_mgmt/v1/registries" {
+			_ = json.NewEncoder(w).Encode(srsmgmtclient.RegistryRestList{
+				Kind:  "RegistryList",
+				Page:  1,
+				Size:  int32(len(registries)),
+				Total: int32(len(registries)),
+				Items: registries,
+			})
+			return
+		}
+		for _, r := range registries {
+			if req.URL.Path == "/api/serviceregistry_mgmt/v1/"+FormatID(r.GetId()) {
+				_ = json.NewEncoder(w).Encode(r)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"kind":"Error","reason":"not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return srsmgmt.NewAPIClient(&srsmgmt.Config{BaseURL: server.URL}).RegistriesApi
+}
+
+func newRegistry(id int32, name string) srsmgmtclient.Registry {
+	r := *srsmgmtclient.NewRegistry(id, srsmgmtclient.AVAILABLE, "https://registry.example.com/t/"+name)
+	r.SetName(name)
+	return r
+}
+
+func TestGetRegistry(t *testing.T) {
+	api := newRegistriesAPI(t, []srsmgmtclient.Registry{
+		newRegistry(1, "my-registry-old"),
+		newRegistry(2, "my-registry"),
+	})
+	ctx := context.Background()
+
+	registry, _, err := GetRegistryByName(ctx, api, "my-registry")
+	if err != nil || registry.GetId() != 2 {
+		t.Errorf("GetRegistryByName() = %+v, %v", registry, err)
+	}
+
+	if _, _, err = GetRegistryByName(ctx, api, "missing"); err == nil || !strings.Contains(err.Error(), `"missing" not found`) {
+		t.Errorf("GetRegistryByName() error = %v, want not found", err)
+	}
+
+	registry, _, err = GetRegistryByID(ctx, api, "1")
+	if err != nil || registry.GetName() != "my-registry-old" {
+		t.Errorf("GetRegistryByID() = %+v, %v", registry, err)
+	}
+
+	if _, _, err = GetRegistryByID(ctx, api, "3"); err == nil || !strings.Contains(err.Error(), `ID "3" not found`) {
+		t.Errorf("GetRegistryByID() error = %v, want not found", err)
+	}
+
+	if _, _, err = GetRegistryByID(ctx, api, "abc"); err == nil || !strings.Contains(err.Error(), "invalid") {
+		t.Errorf("GetRegistryByID() error = %v, want an invalid ID error", err)
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "my-registry"},
+		{name: "", wantErr: true},
+		{name: "My-Registry", wantErr: true},
+		{name: "my-registry-", wantErr: true},
+		{name: "1registry", wantErr: true},
+		{name: strings.Repeat("a", 33), wantErr: true},
+	}
+	for _, tt := range tests {
+		// nolint
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateName(tt.name); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
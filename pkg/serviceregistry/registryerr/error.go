@@ -0,0 +1,28 @@
+package registryerr
+
+import (
+	"fmt"
+)
+
+func NotFoundByIDError(id string) error {
+	return fmt.Errorf(`Service Registry instance with ID "%v" not found`, id)
+}
+
+func NotFoundByNameError(name string) error {
+	return fmt.Errorf(`Service Registry instance "%v" not found`, name)
+}
+
+func InvalidIDError(id string) error {
+	return fmt.Errorf(`invalid Service Registry instance ID "%v", the ID must be a number`, id)
+}
+
+func InvalidNameError(name string) error {
+	return fmt.Errorf(`
+	Invalid Service Registry instance name "%v". Valid names must satisfy the following conditions:
+
+  - must be between 1 and 32 characters
+  - must only consist of lower case, alphanumeric characters and '-'
+  - must start with an alphabetic character
+  - must end with an alphanumeric character
+	`, name)
+}
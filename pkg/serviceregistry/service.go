@@ -0,0 +1,89 @@
+package serviceregistry
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+)
+
+// ServiceName is the name of the Service Registry service used in commands
+const ServiceName = "service-registry"
+
+// InstanceStatus is the status of a Service Registry instance displayed by "rhoas status"
+type InstanceStatus struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Status      string `json:"status,omitempty"`
+	RegistryURL string `json:"registry_url,omitempty" title:"Registry URL"`
+}
+
+// ServiceProvider provides the Service Registry service to the commands which work across services
+type ServiceProvider struct{}
+
+func (ServiceProvider) Name() string {
+	return ServiceName
+}
+
+func (ServiceProvider) Title() string {
+	return "Service Registry"
+}
+
+func (ServiceProvider) CurrentID(cfg *config.Config) string {
+	if !cfg.HasRegistry() {
+		return ""
+	}
+	return cfg.Services.Registry.InstanceID
+}
+
+func (ServiceProvider) Use(cfg *config.Config, id string) {
+	cfg.Services.Registry = &config.RegistryConfig{
+		InstanceID: id,
+	}
+}
+
+func (ServiceProvider) Unuse(cfg *config.Config) {
+	cfg.Services.Registry = nil
+}
+
+func (ServiceProvider) Status(ctx context.Context, api *api.API, id string) (interface{}, error) {
+	registry, _, err := GetRegistryByID(ctx, api.ServiceRegistryMgmt(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstanceStatus{
+		ID:          FormatID(registry.GetId()),
+		Name:        registry.GetName(),
+		Status:      string(registry.GetStatus()),
+		RegistryURL: registry.GetRegistryUrl(),
+	}, nil
+}
+
+// Complete lists all the Service Registry instances page by page and returns the names which start with toComplete
+func (ServiceProvider) Complete(ctx context.Context, api *api.API, toComplete string) ([]string, error) {
+	names := []string{}
+	listed := 0
+	for page := 1; ; page++ {
+		registries, _, err := api.ServiceRegistryMgmt().GetRegistries(ctx).
+			Page(strconv.Itoa(page)).
+			Size(queryLimit).
+			Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range registries.GetItems() {
+			if strings.HasPrefix(r.GetName(), toComplete) {
+				names = append(names, r.GetName())
+			}
+		}
+
+		listed += len(registries.GetItems())
+		if len(registries.GetItems()) == 0 || listed >= int(registries.GetTotal()) {
+			return names, nil
+		}
+	}
+}
@@ -2,34 +2,37 @@ package status
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/redhat-developer/app-services-cli/pkg/connection"
-	"github.com/redhat-developer/app-services-cli/pkg/kafka/kafkaerr"
-	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+	"github.com/redhat-developer/app-services-cli/pkg/service"
 
 	"github.com/openconfig/goyang/pkg/indent"
 	"github.com/redhat-developer/app-services-cli/internal/config"
-	kas "github.com/redhat-developer/app-services-cli/pkg/api/kas"
 	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"gopkg.in/yaml.v2"
 )
 
 const tagTitle = "title"
 
+// Status is the status of the instances of all requested services which are currently used
 type Status struct {
-	Kafka *KafkaStatus `json:"kafka,omitempty" title:"Kafka"`
+	Services []ServiceStatus
 }
 
-type KafkaStatus struct {
-	ID                  string `json:"id,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Status              string `json:"status,omitempty"`
-	BootstrapServerHost string `json:"bootstrap_server_host,omitempty" title:"Bootstrap URL"`
-	FailedReason        string `json:"failed_reason,omitempty" title:"Failed Reason"`
+// ServiceStatus is the status of the instance of a service currently used.
+// Error is set when the status could not be retrieved.
+type ServiceStatus struct {
+	Name   string
+	Title  string
+	Status interface{}
+	Error  error
 }
 
 type Options struct {
@@ -38,10 +41,12 @@ type Options struct {
 	Connection connection.Connection
 
 	// request specific services
-	Services []string
+	Services []service.Provider
 }
 
-// Get gets the status of all services currently set in the user config
+// Get gets the status of all requested services currently set in the user config.
+// The services are queried concurrently, and an error for one service does not prevent
+// the status of the others from being retrieved.
 func Get(ctx context.Context, opts *Options) (status *Status, ok bool, err error) {
 	cfg, err := opts.Config.Load()
 	if err != nil {
@@ -52,46 +57,91 @@ func Get(ctx context.Context, opts *Options) (status *Status, ok bool, err error
 		return nil, false, err
 	}
 
-	status = &Status{}
 	api := opts.Connection.API()
 
-	if stringInSlice("kafka", opts.Services) {
-		kafkaCfg := cfg.Services.Kafka
-		if cfg.HasKafka() {
-			// nolint:govet
-			kafkaStatus, err := getKafkaStatus(ctx, api.Kafka(), kafkaCfg.ClusterID)
-			if err != nil {
-				if kas.IsErr(err, kas.ErrorNotFound) {
-					err = kafkaerr.NotFoundByIDError(kafkaCfg.ClusterID)
-					logger.Error(err)
-					logger.Info(`Run "rhoas kafka use" to use another Kafka instance.`)
-				}
-			} else {
-				status.Kafka = kafkaStatus
-				ok = true
+	results := make([]*ServiceStatus, len(opts.Services))
+	var wg sync.WaitGroup
+	for i, p := range opts.Services {
+		id := p.CurrentID(cfg)
+		if id == "" {
+			logger.Debugf("No %v instance is currently used, skipping status check", p.Title())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, p service.Provider, id string) {
+			defer wg.Done()
+
+			serviceStatus, err := p.Status(ctx, api, id)
+			results[i] = &ServiceStatus{
+				Name:   p.Name(),
+				Title:  p.Title(),
+				Status: serviceStatus,
+				Error:  err,
 			}
-		} else {
-			logger.Debug("No Kafka instance is currently used, skipping status check")
+		}(i, p, id)
+	}
+	wg.Wait()
+
+	status = &Status{}
+	for _, r := range results {
+		if r != nil {
+			status.Services = append(status.Services, *r)
 		}
 	}
 
-	return status, ok, err
+	return status, len(status.Services) > 0, nil
 }
 
-// Print prints the status information of all set services
-func Print(w io.Writer, status *Status) {
-	v := reflect.ValueOf(status).Elem()
+// Failed returns the statuses of the services which could not be retrieved
+func (s *Status) Failed() []ServiceStatus {
+	failed := []ServiceStatus{}
+	for _, svc := range s.Services {
+		if svc.Error != nil {
+			failed = append(failed, svc)
+		}
+	}
+	return failed
+}
+
+// MarshalJSON encodes the status as an object with a field for each service
+func (s *Status) MarshalJSON() ([]byte, error) {
+	m := map[string]interface{}{}
+	for _, svc := range s.Services {
+		m[svc.Name] = svc.value()
+	}
+	return json.Marshal(m)
+}
+
+// MarshalYAML encodes the status as a map with a key for each service, in the order of the services
+func (s *Status) MarshalYAML() (interface{}, error) {
+	m := yaml.MapSlice{}
+	for _, svc := range s.Services {
+		m = append(m, yaml.MapItem{Key: svc.Name, Value: svc.value()})
+	}
+	return m, nil
+}
+
+type serviceError struct {
+	Error string `json:"error" yaml:"error"`
+}
 
-	indirectVal := reflect.Indirect(v)
-	for i := 0; i < indirectVal.NumField(); i++ {
-		fieldType := indirectVal.Type().Field(i)
-		fieldVal := indirectVal.Field(i)
+func (s *ServiceStatus) value() interface{} {
+	if s.Error != nil {
+		return serviceError{Error: s.Error.Error()}
+	}
+	return s.Status
+}
 
-		if !fieldVal.IsNil() {
-			title := getTitle(&fieldType)
-			fmt.Fprintln(w, "")
-			printServiceStatus(w, title, fieldVal)
+// Print prints the status information of all set services
+func Print(w io.Writer, status *Status) {
+	for _, svc := range status.Services {
+		fmt.Fprintln(w, "")
+		if svc.Error != nil {
+			printServiceStatus(w, svc.Title, reflect.ValueOf(serviceError{Error: svc.Error.Error()}))
+			continue
 		}
+		printServiceStatus(w, svc.Title, reflect.ValueOf(svc.Status))
 	}
 }
 
@@ -168,35 +218,3 @@ func createDivider(n int) string {
 
 	return b
 }
-
-func getKafkaStatus(ctx context.Context, api kafkamgmtclient.DefaultApi, id string) (status *KafkaStatus, err error) {
-	kafkaResponse, _, err := api.GetKafkaById(ctx, id).Execute()
-	if kas.IsErr(err, kas.ErrorNotFound) {
-		return nil, kafkaerr.NotFoundByIDError(id)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	status = &KafkaStatus{
-		ID:                  kafkaResponse.GetId(),
-		Name:                kafkaResponse.GetName(),
-		Status:              kafkaResponse.GetStatus(),
-		BootstrapServerHost: kafkaResponse.GetBootstrapServerHost(),
-	}
-
-	if kafkaResponse.GetStatus() == "failed" {
-		status.FailedReason = kafkaResponse.GetFailedReason()
-	}
-
-	return status, err
-}
-
-func stringInSlice(a string, list []string) bool {
-	for _, b := range list {
-		if b == a {
-			return true
-		}
-	}
-	return false
-}
@@ -0,0 +1,155 @@
+package status
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	"github.com/redhat-developer/app-services-cli/internal/mockutil"
+	"github.com/redhat-developer/app-services-cli/pkg/api"
+	"github.com/redhat-developer/app-services-cli/pkg/connection"
+	"github.com/redhat-developer/app-services-cli/pkg/logging"
+	"github.com/redhat-developer/app-services-cli/pkg/service"
+	"gopkg.in/yaml.v2"
+)
+
+type fakeStatus struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// fakeProvider has an instance in use, unless it is named "unused", and records how many statuses are retrieved at the same time
+type fakeProvider struct {
+	name    string
+	err     error
+	delay   time.Duration
+	running *int32
+	maxSeen *int32
+}
+
+func (p fakeProvider) Name() string                     { return p.name }
+func (p fakeProvider) Title() string                    { return strings.ToUpper(p.name) }
+func (p fakeProvider) Use(cfg *config.Config, _ string) {}
+func (p fakeProvider) Unuse(cfg *config.Config)         {}
+
+func (p fakeProvider) CurrentID(cfg *config.Config) string {
+	if p.name == "unused" {
+		return ""
+	}
+	return p.name + "-id"
+}
+
+func (p fakeProvider) Status(ctx context.Context, _ *api.API, id string) (interface{}, error) {
+	n := atomic.AddInt32(p.running, 1)
+	defer atomic.AddInt32(p.running, -1)
+	for {
+		max := atomic.LoadInt32(p.maxSeen)
+		if n <= max || atomic.CompareAndSwapInt32(p.maxSeen, max, n) {
+			break
+		}
+	}
+
+	time.Sleep(p.delay)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &fakeStatus{ID: id, Name: p.name}, nil
+}
+
+func (p fakeProvider) Complete(context.Context, *api.API, string) ([]string, error) {
+	return nil, nil
+}
+
+func TestGet(t *testing.T) {
+	var running, maxSeen int32
+	newProvider := func(name string, err error) service.Provider {
+		return fakeProvider{name: name, err: err, delay: 50 * time.Millisecond, running: &running, maxSeen: &maxSeen}
+	}
+
+	registry := service.NewRegistry(
+		newProvider("kafka", nil),
+		newProvider("unused", nil),
+		newProvider("registry", errors.New("registry is down")),
+		newProvider("other", nil),
+	)
+
+	logger, _ := logging.NewStdLoggerBuilder().Streams(&bytes.Buffer{}, &bytes.Buffer{}).Build()
+	opts := &Options{
+		Config: mockutil.NewConfigMock(&config.Config{}),
+		Logger: func() (logging.Logger, error) {
+			return logger, nil
+		},
+		Connection: &connection.ConnectionMock{
+			APIFunc: func() *api.API { return &api.API{} },
+		},
+		Services: registry.Providers(),
+	}
+
+	status, ok, err := Get(context.Background(), opts)
+	if err != nil || !ok {
+		t.Fatalf("Get() = %v, %v", ok, err)
+	}
+
+	if maxSeen < 3 {
+		t.Errorf("services were not queried concurrently, at most %v at the same time", maxSeen)
+	}
+
+	names := []string{}
+	for _, s := range status.Services {
+		names = append(names, s.Name)
+	}
+	if strings.Join(names, ",") != "kafka,registry,other" {
+		t.Errorf("services = %v, want the used services in order", names)
+	}
+
+	failed := status.Failed()
+	if len(failed) != 1 || failed[0].Name != "registry" {
+		t.Errorf("Failed() = %+v", failed)
+	}
+
+	data, _ := json.Marshal(status)
+	var got map[string]map[string]string
+	_ = json.Unmarshal(data, &got)
+	if got["kafka"]["id"] != "kafka-id" || got["registry"]["error"] != "registry is down" || len(got) != 3 {
+		t.Errorf("JSON = %s", data)
+	}
+
+	yamlData, _ := yaml.Marshal(status)
+	if !strings.HasPrefix(string(yamlData), "kafka:") || !strings.Contains(string(yamlData), "error: registry is down") {
+		t.Errorf("YAML = %s", yamlData)
+	}
+
+	var out bytes.Buffer
+	Print(&out, status)
+	for _, want := range []string{"KAFKA", "kafka-id", "REGISTRY", "registry is down", "OTHER"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Print() output does not contain %q:\n%v", want, out.String())
+		}
+	}
+}
+
+func TestGetNoServicesUsed(t *testing.T) {
+	var running, maxSeen int32
+	logger, _ := logging.NewStdLoggerBuilder().Streams(&bytes.Buffer{}, &bytes.Buffer{}).Build()
+	opts := &Options{
+		Config: mockutil.NewConfigMock(&config.Config{}),
+		Logger: func() (logging.Logger, error) {
+			return logger, nil
+		},
+		Connection: &connection.ConnectionMock{
+			APIFunc: func() *api.API { return &api.API{} },
+		},
+		Services: []service.Provider{fakeProvider{name: "unused", running: &running, maxSeen: &maxSeen}},
+	}
+
+	status, ok, err := Get(context.Background(), opts)
+	if err != nil || ok || len(status.Services) != 0 {
+		t.Errorf("Get() = %+v, %v, %v", status, ok, err)
+	}
+}
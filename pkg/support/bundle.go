@@ -0,0 +1,62 @@
+// Package support contains functions for creating the debug information attached to support cases
+package support
+
+import (
+	"encoding/json"
+	"runtime"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+// Redacted replaces the values which must not leave the machine of the user
+const Redacted = "REDACTED"
+
+// Bundle is the debug information about the CLI and the Kafka instance of a support case
+type Bundle struct {
+	CLIVersion string                        `json:"cli_version"`
+	OS         string                        `json:"os"`
+	Arch       string                        `json:"arch"`
+	GoVersion  string                        `json:"go_version"`
+	Config     *config.Config                `json:"config,omitempty"`
+	Kafka      *kafkamgmtclient.KafkaRequest `json:"kafka,omitempty"`
+}
+
+// NewBundle creates the debug information of a support case, redacting the credentials in cfg
+func NewBundle(cliVersion string, cfg *config.Config, kafka *kafkamgmtclient.KafkaRequest) *Bundle {
+	return &Bundle{
+		CLIVersion: cliVersion,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		GoVersion:  runtime.Version(),
+		Config:     Redact(cfg),
+		Kafka:      kafka,
+	}
+}
+
+// Redact returns a copy of cfg without its tokens
+func Redact(cfg *config.Config) *config.Config {
+	if cfg == nil {
+		return nil
+	}
+
+	redacted := *cfg
+	for _, token := range []*string{
+		&redacted.AccessToken,
+		&redacted.RefreshToken,
+		&redacted.MasAccessToken,
+		&redacted.MasRefreshToken,
+	} {
+		if *token != "" {
+			*token = Redacted
+		}
+	}
+
+	return &redacted
+}
+
+// String returns the debug information as indented JSON
+func (b *Bundle) String() string {
+	data, _ := json.MarshalIndent(b, "", "  ")
+	return string(data)
+}
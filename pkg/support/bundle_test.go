@@ -0,0 +1,39 @@
+package support
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/redhat-developer/app-services-cli/internal/config"
+	kafkamgmtclient "github.com/redhat-developer/app-services-sdk-go/kafkamgmt/apiv1/client"
+)
+
+func TestNewBundle(t *testing.T) {
+	cfg := &config.Config{
+		AccessToken:    "secret-access-token",
+		RefreshToken:   "secret-refresh-token",
+		MasAccessToken: "secret-mas-access-token",
+		APIUrl:         "https://api.openshift.com",
+	}
+	kafka := kafkamgmtclient.KafkaRequest{}
+	kafka.SetId("kafka-id")
+	kafka.SetFailedReason("region is out of capacity")
+
+	bundle := NewBundle("v1.0.0", cfg, &kafka).String()
+
+	if strings.Contains(bundle, "secret") {
+		t.Errorf("bundle contains a token:\n%v", bundle)
+	}
+	for _, want := range []string{`"cli_version": "v1.0.0"`, Redacted, "https://api.openshift.com", "kafka-id", "region is out of capacity"} {
+		if !strings.Contains(bundle, want) {
+			t.Errorf("bundle does not contain %q:\n%v", want, bundle)
+		}
+	}
+
+	if cfg.AccessToken != "secret-access-token" {
+		t.Error("NewBundle() modified the configuration")
+	}
+	if Redact(&config.Config{}).MasRefreshToken != "" {
+		t.Error("Redact() set a token which was empty")
+	}
+}
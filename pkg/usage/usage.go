@@ -0,0 +1,97 @@
+// Package usage contains functions to aggregate the consumption of the organization per product and SKU
+package usage
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+)
+
+// Summary is the current consumption of a product and SKU.
+// The account management service only keeps the latest report of each subscription,
+// so it is the sum of the latest reports of the subscriptions of the product and SKU.
+type Summary struct {
+	Product string `json:"product" yaml:"product"`
+	SKU     string `json:"sku" yaml:"sku"`
+	// Instances is the number of subscriptions which reported their consumption
+	Instances int `json:"instances" yaml:"instances"`
+	// CPU is the number of cores used
+	CPU float64 `json:"cpu_cores" yaml:"cpu_cores"`
+	// Memory is the number of bytes of memory used
+	Memory float64 `json:"memory_bytes" yaml:"memory_bytes"`
+	// LastReported is the time of the latest report of the subscriptions
+	LastReported time.Time `json:"last_reported" yaml:"last_reported"`
+}
+
+// Aggregate sums the latest sample of each subscription by the product and SKU of the subscription.
+// Samples of subscriptions which are not in products, or reported before since, are ignored.
+func Aggregate(samples []accountmgmtutil.UsageSample, products map[string]accountmgmtutil.SubscriptionProduct, since time.Time) []Summary {
+	latest := map[string]accountmgmtutil.UsageSample{}
+	for _, s := range samples {
+		if _, ok := products[s.SubscriptionID]; !ok || s.Time.Before(since) {
+			continue
+		}
+		if l, ok := latest[s.SubscriptionID]; !ok || s.Time.After(l.Time) {
+			latest[s.SubscriptionID] = s
+		}
+	}
+
+	totals := map[accountmgmtutil.SubscriptionProduct]*Summary{}
+	for id, s := range latest {
+		product := products[id]
+		total, ok := totals[product]
+		if !ok {
+			total = &Summary{Product: product.Product, SKU: product.SKU}
+			totals[product] = total
+		}
+		total.Instances++
+		total.CPU += s.CPU
+		total.Memory += s.Memory
+		if s.Time.After(total.LastReported) {
+			total.LastReported = s.Time
+		}
+	}
+
+	summaries := []Summary{}
+	for _, total := range totals {
+		summaries = append(summaries, *total)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Product != summaries[j].Product {
+			return summaries[i].Product < summaries[j].Product
+		}
+		return summaries[i].SKU < summaries[j].SKU
+	})
+
+	return summaries
+}
+
+// WriteCSV writes one line per product and SKU, after a header line
+func WriteCSV(w io.Writer, summaries []Summary) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"product", "sku", "instances", "cpu_cores", "memory_bytes", "last_reported"}); err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		record := []string{
+			s.Product,
+			s.SKU,
+			strconv.Itoa(s.Instances),
+			strconv.FormatFloat(s.CPU, 'f', -1, 64),
+			strconv.FormatFloat(s.Memory, 'f', -1, 64),
+			s.LastReported.UTC().Format(time.RFC3339),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
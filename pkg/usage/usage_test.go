@@ -0,0 +1,55 @@
+package usage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redhat-developer/app-services-cli/pkg/accountmgmtutil"
+)
+
+func TestAggregate(t *testing.T) {
+	since := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return since.Add(time.Duration(h) * time.Hour) }
+
+	products := map[string]accountmgmtutil.SubscriptionProduct{
+		"sub-1": {Product: "RHOSAK", SKU: "MW00530"},
+		"sub-2": {Product: "RHOSAK", SKU: "MW00530"},
+		"sub-3": {Product: "RHOSAKTrial"},
+		"sub-4": {Product: "RHOSAKTrial"},
+	}
+	samples := []accountmgmtutil.UsageSample{
+		{SubscriptionID: "sub-1", Time: at(1), CPU: 2, Memory: 100},
+		{SubscriptionID: "sub-1", Time: at(30), CPU: 3, Memory: 200},
+		{SubscriptionID: "sub-2", Time: at(3), CPU: 1, Memory: 50},
+		{SubscriptionID: "sub-3", Time: at(40), CPU: 1, Memory: 10},
+		// reported before the time window, or of another organization
+		{SubscriptionID: "sub-4", Time: at(-1), CPU: 100},
+		{SubscriptionID: "other", Time: at(1), CPU: 100},
+	}
+
+	summaries := Aggregate(samples, products, since)
+
+	if len(summaries) != 2 || summaries[0].Product != "RHOSAK" || summaries[1].Product != "RHOSAKTrial" {
+		t.Fatalf("Aggregate() = %+v", summaries)
+	}
+
+	// only the latest sample of sub-1 is counted
+	standard := summaries[0]
+	if standard.Instances != 2 || standard.CPU != 4 || standard.Memory != 250 || !standard.LastReported.Equal(at(30)) {
+		t.Errorf("standard = %+v", standard)
+	}
+	if trial := summaries[1]; trial.Instances != 1 || trial.CPU != 1 {
+		t.Errorf("trial = %+v", trial)
+	}
+
+	var out bytes.Buffer
+	if err := WriteCSV(&out, summaries); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || lines[1] != "RHOSAK,MW00530,2,4,250,2021-06-02T06:00:00Z" {
+		t.Errorf("WriteCSV() =\n%v", out.String())
+	}
+}